@@ -0,0 +1,36 @@
+# An Ansible inventory such as:
+#
+#   [web]
+#   web[01:20].example.com
+#
+#   [db]
+#   db01 ansible_host=10.0.1.1
+data "boundary_static_inventory" "web" {
+  source = "${path.module}/inventory/hosts"
+  group  = "web"
+}
+
+resource "boundary_host_catalog_static" "on_prem" {
+  name     = "on-prem"
+  scope_id = boundary_scope.project.id
+}
+
+resource "boundary_host_set_static" "web" {
+  name            = "web"
+  host_catalog_id = boundary_host_catalog_static.on_prem.id
+
+  lifecycle {
+    ignore_changes = [host_ids]
+  }
+}
+
+resource "boundary_host_static_bulk" "web" {
+  host_catalog_id = boundary_host_catalog_static.on_prem.id
+  host_set_id     = boundary_host_set_static.web.id
+  hosts           = data.boundary_static_inventory.web.addresses
+}
+
+# A CSV export with name, address and groups columns
+data "boundary_static_inventory" "cmdb" {
+  source = "${path.module}/cmdb.csv"
+}
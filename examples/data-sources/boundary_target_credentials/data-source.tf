@@ -0,0 +1,11 @@
+data "boundary_target_credentials" "postgres" {
+  target_id = boundary_target_ssh.postgres.id
+}
+
+# Fail the run when a credential source would not work for the target
+check "credential_mappings" {
+  assert {
+    condition     = alltrue([for s in data.boundary_target_credentials.postgres.credential_sources : s.mapping_valid])
+    error_message = join("\n", [for s in data.boundary_target_credentials.postgres.credential_sources : "${s.id}: ${s.mapping_error}" if !s.mapping_valid])
+  }
+}
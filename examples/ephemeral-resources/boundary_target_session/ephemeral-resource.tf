@@ -0,0 +1,30 @@
+data "boundary_scope" "org" {
+  name     = "SecOps"
+  scope_id = "global"
+}
+
+data "boundary_scope" "project" {
+  name     = "databases"
+  scope_id = data.boundary_scope.org.id
+}
+
+resource "boundary_target_tcp" "postgres" {
+  name         = "postgres"
+  scope_id     = data.boundary_scope.project.id
+  default_port = 5432
+  address      = "db.internal"
+}
+
+# The session stays open while Terraform uses the connection, and is cancelled
+# when the ephemeral resource is released at the end of the run.
+ephemeral "boundary_target_session" "postgres" {
+  target_id = boundary_target_tcp.postgres.id
+}
+
+provider "postgresql" {
+  host     = ephemeral.boundary_target_session.postgres.address
+  port     = ephemeral.boundary_target_session.postgres.port
+  username = ephemeral.boundary_target_session.postgres.credentials[0].username
+  password = ephemeral.boundary_target_session.postgres.credentials[0].password
+  sslmode  = "disable"
+}
@@ -0,0 +1 @@
+terraform import boundary_global_scope.global global
@@ -0,0 +1,5 @@
+resource "boundary_global_scope" "global" {
+  name              = "global"
+  description       = "The global scope"
+  storage_policy_id = boundary_policy_storage.example.id
+}
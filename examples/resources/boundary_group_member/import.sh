@@ -0,0 +1 @@
+terraform import boundary_group_member.foo <group-id>:<member-id>
\ No newline at end of file
@@ -0,0 +1,27 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_group" "engineering" {
+  name        = "engineering"
+  description = "Group managed by a central module"
+  scope_id    = boundary_scope.org.id
+
+  lifecycle {
+    ignore_changes = [member_ids]
+  }
+}
+
+resource "boundary_user" "foo" {
+  name     = "foo"
+  scope_id = boundary_scope.org.id
+}
+
+resource "boundary_group_member" "foo" {
+  group_id  = boundary_group.engineering.id
+  member_id = boundary_user.foo.id
+}
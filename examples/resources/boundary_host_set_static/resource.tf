@@ -38,3 +38,9 @@ resource "boundary_host_set_static" "web" {
     boundary_host_static.second.id,
   ]
 }
+
+resource "boundary_host_set_static" "web_by_name" {
+  host_catalog_id = boundary_host_catalog_static.example.id
+  host_names      = ["host_1", "host_2"]
+  depends_on      = [boundary_host_static.first, boundary_host_static.second]
+}
@@ -0,0 +1,4 @@
+# A bulk static host resource is imported with the ID of its host catalog and
+# the names of the hosts to import, separated by commas. Other hosts of the
+# catalog are not imported.
+terraform import boundary_host_static_bulk.foo <my-host-catalog-id>:<name>,<name>
@@ -0,0 +1,40 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_scope" "project" {
+  name                   = "project_one"
+  description            = "My first scope!"
+  scope_id               = boundary_scope.org.id
+  auto_create_admin_role = true
+}
+
+resource "boundary_host_catalog_static" "example" {
+  name        = "My catalog"
+  description = "My first host catalog!"
+  scope_id    = boundary_scope.project.id
+}
+
+resource "boundary_host_set_static" "web" {
+  name            = "web"
+  host_catalog_id = boundary_host_catalog_static.example.id
+
+  # the membership of the hosts is managed by boundary_host_static_bulk
+  lifecycle {
+    ignore_changes = [host_ids]
+  }
+}
+
+resource "boundary_host_static_bulk" "web" {
+  host_catalog_id = boundary_host_catalog_static.example.id
+  host_set_id     = boundary_host_set_static.web.id
+  hosts = {
+    "web-1" = "10.0.0.1"
+    "web-2" = "10.0.0.2"
+    "web-3" = "web-3.example.com"
+  }
+}
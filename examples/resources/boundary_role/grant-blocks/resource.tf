@@ -0,0 +1,28 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_user" "operator" {
+  name        = "operator"
+  description = "An operator"
+  scope_id    = boundary_scope.org.id
+}
+
+resource "boundary_role" "operator" {
+  name          = "operator"
+  description   = "Can connect to targets"
+  principal_ids = [boundary_user.operator.id]
+  grant_strings = ["ids=*;type=*;actions=read"]
+  scope_id      = boundary_scope.org.id
+
+  grant {
+    ids           = ["*"]
+    type          = "target"
+    actions       = ["read", "authorize-session"]
+    output_fields = ["id", "name"]
+  }
+}
@@ -0,0 +1 @@
+terraform import boundary_role_grant.foo "<role-id>:<grant-string>"
\ No newline at end of file
@@ -0,0 +1,22 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_role" "shared" {
+  name        = "Shared role"
+  description = "A role shared between several modules"
+  scope_id    = boundary_scope.org.id
+
+  lifecycle {
+    ignore_changes = [grant_strings]
+  }
+}
+
+resource "boundary_role_grant" "targets" {
+  role_id      = boundary_role.shared.id
+  grant_string = "ids=*;type=target;actions=read,authorize-session"
+}
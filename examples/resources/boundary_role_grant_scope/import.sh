@@ -0,0 +1 @@
+terraform import boundary_role_grant_scope.foo <role-id>:<grant-scope-id>
\ No newline at end of file
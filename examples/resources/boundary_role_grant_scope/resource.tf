@@ -0,0 +1,25 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_scope" "project" {
+  name                   = "project_one"
+  description            = "My first scope!"
+  scope_id               = boundary_scope.org.id
+  auto_create_admin_role = true
+}
+
+resource "boundary_role" "shared" {
+  name        = "Shared role"
+  description = "A role shared between several modules"
+  scope_id    = boundary_scope.org.id
+}
+
+resource "boundary_role_grant_scope" "project" {
+  role_id        = boundary_role.shared.id
+  grant_scope_id = boundary_scope.project.id
+}
@@ -0,0 +1 @@
+terraform import boundary_role_principal.foo <role-id>:<principal-id>
\ No newline at end of file
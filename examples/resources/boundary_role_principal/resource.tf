@@ -0,0 +1,27 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_role" "shared" {
+  name        = "Shared role"
+  description = "A role shared between several modules"
+  scope_id    = boundary_scope.org.id
+
+  lifecycle {
+    ignore_changes = [principal_ids]
+  }
+}
+
+resource "boundary_group" "team" {
+  name     = "team"
+  scope_id = boundary_scope.org.id
+}
+
+resource "boundary_role_principal" "team" {
+  role_id      = boundary_role.shared.id
+  principal_id = boundary_group.team.id
+}
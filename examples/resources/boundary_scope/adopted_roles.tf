@@ -0,0 +1,21 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = boundary_scope.global.id
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+
+  # principal_ids is omitted, so the user that created the scope keeps the role
+  admin_role {
+    grant_strings = ["ids=*;type=*;actions=*"]
+  }
+
+  default_role {
+    grant_strings = [
+      "ids=*;type=scope;actions=list,no-op",
+      "ids=*;type=auth-method;actions=list,authenticate",
+      "ids={{.Account.Id}};actions=read,change-password",
+    ]
+    principal_ids = ["u_anon"]
+  }
+}
@@ -0,0 +1 @@
+terraform import boundary_scope_key_rotation.foo <scope-id>
@@ -0,0 +1,17 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "time_rotating" "quarterly" {
+  rotation_days = 90
+}
+
+resource "boundary_scope_key_rotation" "org" {
+  scope_id         = boundary_scope.org.id
+  rotation_trigger = time_rotating.quarterly.id
+  rewrap           = true
+}
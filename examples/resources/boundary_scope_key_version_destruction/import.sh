@@ -0,0 +1 @@
+terraform import boundary_scope_key_version_destruction.foo <scope-id>:<key-version-id>
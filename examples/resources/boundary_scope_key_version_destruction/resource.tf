@@ -0,0 +1,4 @@
+resource "boundary_scope_key_version_destruction" "old_database_key" {
+  scope_id       = boundary_scope.org.id
+  key_version_id = "krkv_1234567890"
+}
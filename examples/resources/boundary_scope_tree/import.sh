@@ -0,0 +1 @@
+terraform import boundary_scope_tree.foo <org-scope-id>
@@ -0,0 +1,20 @@
+resource "boundary_scope_tree" "tenant" {
+  scope_id               = "global"
+  name                   = "tenant_one"
+  description            = "Scopes of the first tenant"
+  auto_create_admin_role = true
+
+  project {
+    key         = "dev"
+    description = "Development"
+  }
+
+  project {
+    key  = "prod"
+    name = "production"
+  }
+}
+
+resource "boundary_host_catalog_static" "prod" {
+  scope_id = boundary_scope_tree.tenant.project_ids["production"]
+}
@@ -0,0 +1,21 @@
+# Moves an existing boundary_target of type "ssh" to boundary_target_ssh
+# without recreating it. Requires Terraform 1.7 or later. Remove both blocks
+# once the plan has been applied.
+removed {
+  from = boundary_target.foo
+
+  lifecycle {
+    destroy = false
+  }
+}
+
+import {
+  to = boundary_target_ssh.foo
+  id = "tssh_1234567890" # the ID of boundary_target.foo
+}
+
+resource "boundary_target_ssh" "foo" {
+  name         = "foo"
+  scope_id     = boundary_scope.project.id
+  default_port = 22
+}
@@ -0,0 +1,45 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_scope" "project" {
+  name                   = "project_one"
+  description            = "My first scope!"
+  scope_id               = boundary_scope.org.id
+  auto_create_admin_role = true
+}
+
+resource "boundary_credential_store_vault" "foo" {
+  name     = "vault_store"
+  address  = "http://127.0.0.1:8200"      # change to Vault address
+  token    = "s.0ufRo6XEGU2jOqnIr7OlFYP5" # change to valid Vault token
+  scope_id = boundary_scope.project.id
+}
+
+resource "boundary_credential_library_vault" "foo" {
+  name                = "foo"
+  credential_store_id = boundary_credential_store_vault.foo.id
+  path                = "my/secret/foo" # change to Vault backend path
+  http_method         = "GET"
+}
+
+resource "boundary_target" "shared" {
+  name         = "shared"
+  type         = "tcp"
+  default_port = "22"
+  scope_id     = boundary_scope.project.id
+
+  lifecycle {
+    ignore_changes = [brokered_credential_source_ids]
+  }
+}
+
+resource "boundary_target_credential_source" "foo" {
+  target_id            = boundary_target.shared.id
+  credential_source_id = boundary_credential_library_vault.foo.id
+  purpose              = "brokered"
+}
@@ -0,0 +1,40 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_scope" "project" {
+  name                   = "project_one"
+  description            = "My first scope!"
+  scope_id               = boundary_scope.org.id
+  auto_create_admin_role = true
+}
+
+resource "boundary_host_catalog_static" "foo" {
+  name     = "test"
+  scope_id = boundary_scope.project.id
+}
+
+resource "boundary_host_set_static" "foo" {
+  name            = "foo"
+  host_catalog_id = boundary_host_catalog_static.foo.id
+}
+
+resource "boundary_target" "shared" {
+  name         = "shared"
+  type         = "tcp"
+  default_port = "22"
+  scope_id     = boundary_scope.project.id
+
+  lifecycle {
+    ignore_changes = [host_source_ids]
+  }
+}
+
+resource "boundary_target_host_source" "foo" {
+  target_id      = boundary_target.shared.id
+  host_source_id = boundary_host_set_static.foo.id
+}
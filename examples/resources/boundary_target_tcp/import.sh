@@ -0,0 +1 @@
+terraform import boundary_target_tcp.foo <my-id>
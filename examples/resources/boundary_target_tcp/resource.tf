@@ -0,0 +1,79 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_scope" "project" {
+  name                   = "project_one"
+  description            = "My first scope!"
+  scope_id               = boundary_scope.org.id
+  auto_create_admin_role = true
+}
+
+resource "boundary_host_catalog_static" "foo" {
+  name     = "test"
+  scope_id = boundary_scope.project.id
+}
+
+resource "boundary_host_static" "foo" {
+  name            = "foo"
+  host_catalog_id = boundary_host_catalog_static.foo.id
+  address         = "10.0.0.1"
+}
+
+resource "boundary_host_set_static" "foo" {
+  name            = "foo"
+  host_catalog_id = boundary_host_catalog_static.foo.id
+  host_ids        = [boundary_host_static.foo.id]
+}
+
+resource "boundary_target_tcp" "postgres" {
+  name                     = "postgres"
+  description              = "PostgreSQL target"
+  scope_id                 = boundary_scope.project.id
+  default_port             = 5432
+  default_client_port      = 15432
+  session_connection_limit = -1
+  host_source_ids          = [boundary_host_set_static.foo.id]
+}
+
+resource "boundary_target_tcp" "address" {
+  name         = "address"
+  description  = "Target with an address"
+  scope_id     = boundary_scope.project.id
+  default_port = 22
+  address      = "127.0.0.1"
+}
+
+resource "boundary_target_tcp" "with_aliases" {
+  name            = "with_aliases"
+  description     = "Target reachable through aliases"
+  scope_id        = boundary_scope.project.id
+  default_port    = 5432
+  host_source_ids = [boundary_host_set_static.foo.id]
+
+  alias {
+    value = "postgres.example"
+  }
+
+  alias {
+    value                     = "postgres-foo.example"
+    authorize_session_host_id = boundary_host_static.foo.id
+  }
+}
+
+resource "boundary_target_tcp" "by_name" {
+  name         = "by_name"
+  description  = "Target with host sources referenced by name"
+  scope_id     = boundary_scope.project.id
+  default_port = 5432
+  depends_on   = [boundary_host_set_static.foo]
+
+  host_sources {
+    host_catalog_name = "test"
+    host_set_name     = "foo"
+  }
+}
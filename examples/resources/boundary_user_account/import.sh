@@ -0,0 +1 @@
+terraform import boundary_user_account.foo <user-id>:<account-id>
\ No newline at end of file
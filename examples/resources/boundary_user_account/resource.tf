@@ -0,0 +1,32 @@
+resource "boundary_scope" "org" {
+  name                     = "organization_one"
+  description              = "My first scope!"
+  scope_id                 = "global"
+  auto_create_admin_role   = true
+  auto_create_default_role = true
+}
+
+resource "boundary_auth_method" "password" {
+  scope_id = boundary_scope.org.id
+  type     = "password"
+}
+
+resource "boundary_account_password" "jeff" {
+  auth_method_id = boundary_auth_method.password.id
+  login_name     = "jeff"
+  password       = "$uper$ecure"
+}
+
+resource "boundary_user" "jeff" {
+  name     = "jeff"
+  scope_id = boundary_scope.org.id
+
+  lifecycle {
+    ignore_changes = [account_ids]
+  }
+}
+
+resource "boundary_user_account" "jeff" {
+  user_id    = boundary_user.jeff.id
+  account_id = boundary_account_password.jeff.id
+}
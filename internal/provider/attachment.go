@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// attachmentIdSeparator separates the parts of the composite IDs used by
+// attachment resources, e.g. "r_1234567890:u_1234567890". Boundary resource
+// IDs never contain it.
+const attachmentIdSeparator = ":"
+
+// attachmentId builds the composite ID of an attachment resource from the ID
+// of the parent resource followed by the attached values.
+func attachmentId(parts ...string) string {
+	return strings.Join(parts, attachmentIdSeparator)
+}
+
+// splitAttachmentId splits a composite ID created by attachmentId into
+// exactly n parts. Only the first n-1 separators are considered, so the last
+// part may itself contain the separator, as JSON grant strings do.
+func splitAttachmentId(id string, n int) ([]string, error) {
+	parts := strings.SplitN(id, attachmentIdSeparator, n)
+	if len(parts) != n {
+		return nil, fmt.Errorf("unexpected format of ID %q, expected %d parts separated by %q", id, n, attachmentIdSeparator)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("unexpected format of ID %q, found an empty part", id)
+		}
+	}
+	return parts, nil
+}
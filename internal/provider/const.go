@@ -35,6 +35,8 @@ const (
 	PreferredEndpointsKey = "preferred_endpoints"
 	// SyncIntervalSecondsKey is used for setting the interval seconds
 	SyncIntervalSecondsKey = "sync_interval_seconds"
+	// syncIntervalKey is used for setting the interval as a duration string
+	syncIntervalKey = "sync_interval"
 	// internalSecretsConfigHmacKey is used for storing an hmac of hmac from server +
 	// config string
 	internalSecretsConfigHmacKey = "internal_secrets_config_hmac"
@@ -27,10 +27,10 @@ func dataSourceAccount() *schema.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			AuthMethodIdKey: {
-				Description:  "The auth method ID that will be queried for the account.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				Description:      "The auth method ID that will be queried for the account.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.AllDiag(validation.ToDiagFunc(validation.StringIsNotEmpty), validateId(authMethodIds...)),
 			},
 			IDKey: {
 				Description: "The ID of the retrieved account.",
@@ -27,11 +27,11 @@ func dataSourceAuthMethod() *schema.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			ScopeIdKey: {
-				Description:  "The scope ID in which the resource is created. Defaults `global` if unset.",
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "global",
-				ValidateFunc: validation.StringIsNotEmpty,
+				Description:      "The scope ID in which the resource is created. Defaults `global` if unset.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "global",
+				ValidateDiagFunc: validation.AllDiag(validation.ToDiagFunc(validation.StringIsNotEmpty), validateId(orgScopeIds...)),
 			},
 			IDKey: {
 				Description: "The ID of the retrieved auth method.",
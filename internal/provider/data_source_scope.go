@@ -35,9 +35,10 @@ func dataSourceScope() *schema.Resource {
 				Computed:    true,
 			},
 			ScopeIdKey: {
-				Description: "The parent scope ID that will be queried for the scope.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:      "The parent scope ID that will be queried for the scope.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateId(orgScopeIds...),
 			},
 		},
 	}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	staticInventorySourceKey    = "source"
+	staticInventoryFormatKey    = "format"
+	staticInventoryGroupKey     = "group"
+	staticInventoryHostsKey     = "hosts"
+	staticInventoryAddressesKey = "addresses"
+	staticInventoryGroupsKey    = "groups"
+)
+
+func dataSourceStaticInventory() *schema.Resource {
+	return &schema.Resource{
+		Description: "The boundary_static_inventory data source parses an inventory of hosts, such as an Ansible " +
+			"inventory or a CSV export, into host records that can be used to configure static hosts and host " +
+			"sets. The addresses of the hosts are validated. No call is made to Boundary.",
+		ReadContext: dataSourceStaticInventoryRead,
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "A hash of the contents of the inventory.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			staticInventorySourceKey: {
+				Description: "The path to the inventory file, or the contents of the inventory.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			staticInventoryFormatKey: {
+				Description: "The format of the inventory: `ini` or `yaml` for Ansible inventories, `csv` for a " +
+					"CSV file with a header row and `name`, `address` and optional `groups` columns, the groups being " +
+					"separated by semicolons, or `json` for a list of objects with `name`, `address` and `groups` " +
+					"attributes or the output of `ansible-inventory --list`. Deduced from the extension of the " +
+					"file when unset.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(inventoryFormats, false),
+			},
+			staticInventoryGroupKey: {
+				Description: "Only return the hosts of this group, including the ones in its child groups.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			staticInventoryHostsKey: {
+				Description: "The hosts of the inventory, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						NameKey: {
+							Description: "The name of the host.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						hostAddressKey: {
+							Description: "The address of the host. For Ansible inventories this is the " +
+								"`ansible_host` variable of the host, or its name when unset.",
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						staticInventoryGroupsKey: {
+							Description: "The groups of the host, including the parents of its groups. The " +
+								"implicit `all` and `ungrouped` groups of Ansible are not included.",
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			staticInventoryAddressesKey: {
+				Description: "The addresses of the hosts as a map of their name to their address, as expected by " +
+					"the `hosts` attribute of `boundary_host_static_bulk`.",
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceStaticInventoryRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	source := d.Get(staticInventorySourceKey).(string)
+	contents, wasPath, err := ReadPathOrContents(source)
+	if err != nil {
+		return diag.Errorf("error reading inventory %q: %v", source, err)
+	}
+	format, err := inventoryFormat(d.Get(staticInventoryFormatKey).(string), source, wasPath, contents)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	hosts, err := parseInventory(format, contents)
+	if err != nil {
+		return diag.Errorf("error parsing %s inventory: %v", format, err)
+	}
+
+	group := d.Get(staticInventoryGroupKey).(string)
+	var diags diag.Diagnostics
+	hostsList := make([]interface{}, 0, len(hosts))
+	addresses := make(map[string]interface{}, len(hosts))
+	for _, h := range hosts {
+		if group != "" && !slices.Contains(h.Groups, group) {
+			continue
+		}
+		if err := checkHostAddress(h.Address); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("invalid address for host %q", h.Name),
+				Detail:        err.Error(),
+				AttributePath: cty.GetAttrPath(staticInventorySourceKey),
+			})
+			continue
+		}
+		hostsList = append(hostsList, map[string]interface{}{
+			NameKey:                  h.Name,
+			hostAddressKey:           h.Address,
+			staticInventoryGroupsKey: h.Groups,
+		})
+		addresses[h.Name] = h.Address
+	}
+	if diags.HasError() {
+		return diags
+	}
+	if group != "" && len(hostsList) == 0 {
+		return diag.Errorf("the inventory has no hosts in the group %q", group)
+	}
+
+	if err := d.Set(staticInventoryHostsKey, hostsList); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(staticInventoryAddressesKey, addresses); err != nil {
+		return diag.FromErr(err)
+	}
+	sum := sha256.Sum256([]byte(contents))
+	d.SetId(hex.EncodeToString(sum[:]))
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	staticInventoryIni = `
+[web]
+web[1:2] ansible_host=10.0.0.1%s
+
+[db]
+db1 ansible_host=10.0.1.1
+
+[prod:children]
+web
+db
+`
+
+	staticInventoryRead = `
+data "boundary_static_inventory" "web" {
+	source = "%s"
+	group  = "web"
+}
+
+resource "boundary_host_catalog_static" "foo" {
+	name       = "test"
+	scope_id   = boundary_scope.proj1.id
+	depends_on = [boundary_role.proj1_admin]
+}
+
+resource "boundary_host_static_bulk" "web" {
+	host_catalog_id = boundary_host_catalog_static.foo.id
+	hosts           = data.boundary_static_inventory.web.addresses
+}`
+)
+
+func TestAccStaticInventoryRead(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	path := filepath.Join(t.TempDir(), "hosts")
+	writeInventory := func(suffix string) func() {
+		return func() {
+			if err := os.WriteFile(path, []byte(fmt.Sprintf(staticInventoryIni, suffix)), 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	writeInventory("")()
+
+	const name = "data.boundary_static_inventory.web"
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckHostStaticBulkDestroy(provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(staticInventoryRead, path)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "hosts.#", "2"),
+					resource.TestCheckResourceAttr(name, "hosts.0.name", "web1"),
+					resource.TestCheckResourceAttr(name, "hosts.0.address", "10.0.0.1"),
+					resource.TestCheckResourceAttr(name, "hosts.0.groups.#", "2"),
+					resource.TestCheckResourceAttr(name, "hosts.0.groups.0", "prod"),
+					resource.TestCheckResourceAttr(name, "hosts.0.groups.1", "web"),
+					resource.TestCheckResourceAttr(name, "addresses.%", "2"),
+					resource.TestCheckResourceAttr(name, "addresses.web2", "10.0.0.1"),
+					resource.TestCheckResourceAttr("boundary_host_static_bulk.web", "host_ids.%", "2"),
+				),
+			},
+			{
+				// the address of web1 and web2 becomes 10.0.0.1:22
+				PreConfig:   writeInventory(":22"),
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(staticInventoryRead, path)),
+				ExpectError: regexp.MustCompile(`invalid address for host "web1"`),
+			},
+			{
+				// the inventory is fixed so that it can be read when destroying
+				PreConfig: writeInventory(""),
+				Config:    testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(staticInventoryRead, path)),
+				Check:     resource.TestCheckResourceAttr(name, "hosts.#", "2"),
+			},
+		},
+	})
+}
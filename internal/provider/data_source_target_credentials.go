@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	targetCredentialsTargetIdKey     = "target_id"
+	targetCredentialsTargetTypeKey   = "target_type"
+	targetCredentialsSourcesKey      = "credential_sources"
+	targetCredentialsMappingValidKey = "mapping_valid"
+	targetCredentialsMappingErrorKey = "mapping_error"
+
+	credentialTypeUsernamePassword       = "username_password"
+	credentialTypeUsernamePasswordDomain = "username_password_domain"
+	credentialTypeSshPrivateKey          = "ssh_private_key"
+	credentialTypeSshCertificate         = "ssh_certificate"
+)
+
+// credentialMappingDefaults are the attributes of the Vault secrets Boundary
+// maps to the fields of each credential type, unless they are overridden by
+// the credential_mapping_overrides of a generic Vault library.
+var credentialMappingDefaults = map[string]map[string]string{
+	credentialTypeUsernamePassword: {
+		"username_attribute": "username",
+		"password_attribute": "password",
+	},
+	credentialTypeUsernamePasswordDomain: {
+		"username_attribute": "username",
+		"password_attribute": "password",
+		"domain_attribute":   "domain",
+	},
+	credentialTypeSshPrivateKey: {
+		"username_attribute":               "username",
+		"private_key_attribute":            "private_key",
+		"private_key_passphrase_attribute": "private_key_passphrase",
+	},
+}
+
+// injectedCredentialTypes are the credential types that can be injected into
+// the sessions of each target type.
+var injectedCredentialTypes = map[string][]string{
+	targetTypeSsh: {credentialTypeUsernamePassword, credentialTypeSshPrivateKey, credentialTypeSshCertificate},
+}
+
+func dataSourceTargetCredentials() *schema.Resource {
+	return &schema.Resource{
+		Description: "The boundary_target_credentials data source lists the brokered and injected application " +
+			"credential sources of a target, along with the credential type and fields a session would receive " +
+			"from each of them. No session is authorized.",
+		ReadContext: dataSourceTargetCredentialsRead,
+
+		Schema: map[string]*schema.Schema{
+			targetCredentialsTargetIdKey: {
+				Description:      "The ID of the target.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateId(targetIds),
+			},
+			targetCredentialsTargetTypeKey: {
+				Description: "The type of the target.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			targetCredentialsSourcesKey: {
+				Description: "The credential sources of the target.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the credential source.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						NameKey: {
+							Description: "The name of the credential source.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						TypeKey: {
+							Description: "The type of the credential source, such as `vault-generic`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						targetCredentialSourcePurposeKey: {
+							Description: "How the credentials are given to sessions, either `brokered` or `injected_application`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						credentialLibraryCredentialTypeKey: {
+							Description: "The type of the credentials, empty for untyped credentials.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						credentialLibraryCredentialMappingOverridesKey: {
+							Description: "The attributes of the secret the credential fields are read from, including " +
+								"the defaults that are not overridden. Only set for typed generic Vault libraries.",
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						targetCredentialsMappingValidKey: {
+							Description: "Whether the credential type and mapping are valid for the target and purpose.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						targetCredentialsMappingErrorKey: {
+							Description: "Why the credential type or mapping is not valid, if it is not.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTargetCredentialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+	clc := credentiallibraries.NewClient(md.client)
+
+	targetId := d.Get(targetCredentialsTargetIdKey).(string)
+	trr, err := tc.Read(ctx, targetId)
+	if err != nil {
+		return diag.Errorf("error reading target: %v", err)
+	}
+	if trr == nil {
+		return diag.Errorf("target nil after read")
+	}
+	target := trr.Item
+
+	purposes := []struct {
+		purpose string
+		sources []*targets.CredentialSource
+	}{
+		{credentialPurposeBrokered, target.BrokeredCredentialSources},
+		{credentialPurposeInjectedApplication, target.InjectedApplicationCredentialSources},
+	}
+
+	var sources []interface{}
+	for _, p := range purposes {
+		for _, source := range p.sources {
+			credentialType := source.CredentialType
+			var overrides map[string]interface{}
+			if credentialLibraryIds.matches(source.Id) {
+				clr, err := clc.Read(ctx, source.Id)
+				if err != nil {
+					return diag.Errorf("error reading credential library %q: %v", source.Id, err)
+				}
+				if clr == nil {
+					return diag.Errorf("credential library %q nil after read", source.Id)
+				}
+				credentialType = clr.Item.CredentialType
+				overrides = clr.Item.CredentialMappingOverrides
+			}
+
+			effective, err := credentialSourceMapping(target.Type, p.purpose, source.Type, credentialType, overrides)
+			var mappingError string
+			if err != nil {
+				mappingError = err.Error()
+			}
+			sources = append(sources, map[string]interface{}{
+				IDKey:                              source.Id,
+				NameKey:                            source.Name,
+				TypeKey:                            source.Type,
+				targetCredentialSourcePurposeKey:   p.purpose,
+				credentialLibraryCredentialTypeKey: credentialType,
+				credentialLibraryCredentialMappingOverridesKey: effective,
+				targetCredentialsMappingValidKey:               err == nil,
+				targetCredentialsMappingErrorKey:               mappingError,
+			})
+		}
+	}
+
+	if err := d.Set(targetCredentialsTargetTypeKey, target.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(targetCredentialsSourcesKey, sources); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(target.Id)
+	return nil
+}
+
+// credentialSourceMapping returns the effective credential mapping of a
+// credential source, and an error if its credentials cannot be given to
+// sessions of the target type for the purpose.
+func credentialSourceMapping(targetType, purpose, sourceType, credentialType string, overrides map[string]interface{}) (map[string]string, error) {
+	defaults, typed := credentialMappingDefaults[credentialType]
+	effective := map[string]string{}
+	if sourceType == credentialLibraryVaultType && typed {
+		maps.Copy(effective, defaults)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(overrides)) {
+		if !typed {
+			return effective, fmt.Errorf("credential mapping overrides require one of the credential types %s, not %q",
+				quotedList(slices.Sorted(maps.Keys(credentialMappingDefaults))), credentialType)
+		}
+		if _, ok := defaults[key]; !ok {
+			return effective, fmt.Errorf("%q is not a mapping attribute of %s credentials, expected %s",
+				key, credentialType, quotedList(slices.Sorted(maps.Keys(defaults))))
+		}
+		value, ok := overrides[key].(string)
+		if !ok {
+			return effective, fmt.Errorf("the mapping attribute %q must be a string", key)
+		}
+		effective[key] = value
+	}
+
+	if purpose == credentialPurposeInjectedApplication {
+		types, ok := injectedCredentialTypes[targetType]
+		if !ok {
+			return effective, fmt.Errorf("injected application credentials are not supported on %s targets", targetType)
+		}
+		if !slices.Contains(types, credentialType) {
+			typeStr := strconv.Quote(credentialType)
+			if credentialType == "" {
+				typeStr = "untyped"
+			}
+			return effective, fmt.Errorf("%s credentials cannot be injected into %s targets, expected %s",
+				typeStr, targetType, quotedList(types))
+		}
+	}
+	return effective, nil
+}
+
+// quotedList quotes the elements of s and joins them into a list of
+// alternatives.
+func quotedList(s []string) string {
+	quoted := make([]string, 0, len(s))
+	for _, e := range s {
+		quoted = append(quoted, strconv.Quote(e))
+	}
+	return joinOr(quoted)
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/boundary/testing/vault"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const targetCredentialsRead = `
+resource "boundary_credential_library_vault" "untyped" {
+	name                = "untyped"
+	credential_store_id = boundary_credential_store_vault.example.id
+	path                = "foo/bar"
+	http_method         = "GET"
+}
+
+resource "boundary_credential_library_vault" "typed" {
+	name                = "typed"
+	credential_store_id = boundary_credential_store_vault.example.id
+	path                = "bar/foo"
+	http_method         = "GET"
+	credential_type     = "username_password"
+	credential_mapping_overrides = {
+		password_attribute = "secret"
+	}
+}
+
+resource "boundary_target_ssh" "foo" {
+	name                                       = "test"
+	scope_id                                   = boundary_scope.proj1.id
+	address                                    = "127.0.0.1"
+	default_port                               = 22
+	brokered_credential_source_ids             = [boundary_credential_library_vault.untyped.id]
+	injected_application_credential_source_ids = [boundary_credential_library_vault.typed.id]
+	depends_on                                 = [boundary_role.proj1_admin]
+}
+
+data "boundary_target_credentials" "foo" {
+	target_id = boundary_target_ssh.foo.id
+}`
+
+func TestAccTargetCredentialsRead(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	vc := vault.NewTestVaultServer(t)
+	_, token := vc.CreateToken(t)
+	credStoreRes := vaultCredStoreResource(vc, vaultCredStoreName, vaultCredStoreDesc, vaultCredStoreNamespace, "www.original.com", token, true)
+
+	const name = "data.boundary_target_credentials.foo"
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, targetCredentialsRead),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, IDKey, "boundary_target_ssh.foo", IDKey),
+					resource.TestCheckResourceAttr(name, targetCredentialsTargetTypeKey, targetTypeSsh),
+					resource.TestCheckResourceAttr(name, "credential_sources.#", "2"),
+
+					resource.TestCheckResourceAttrPair(name, "credential_sources.0.id", "boundary_credential_library_vault.untyped", IDKey),
+					resource.TestCheckResourceAttr(name, "credential_sources.0.purpose", credentialPurposeBrokered),
+					resource.TestCheckResourceAttr(name, "credential_sources.0.type", credentialLibraryVaultType),
+					resource.TestCheckResourceAttr(name, "credential_sources.0.credential_type", ""),
+					resource.TestCheckResourceAttr(name, "credential_sources.0.credential_mapping_overrides.%", "0"),
+					resource.TestCheckResourceAttr(name, "credential_sources.0.mapping_valid", "true"),
+
+					resource.TestCheckResourceAttrPair(name, "credential_sources.1.id", "boundary_credential_library_vault.typed", IDKey),
+					resource.TestCheckResourceAttr(name, "credential_sources.1.purpose", credentialPurposeInjectedApplication),
+					resource.TestCheckResourceAttr(name, "credential_sources.1.credential_type", credentialTypeUsernamePassword),
+					resource.TestCheckResourceAttr(name, "credential_sources.1.credential_mapping_overrides.%", "2"),
+					resource.TestCheckResourceAttr(name, "credential_sources.1.credential_mapping_overrides.username_attribute", "username"),
+					resource.TestCheckResourceAttr(name, "credential_sources.1.credential_mapping_overrides.password_attribute", "secret"),
+					resource.TestCheckResourceAttr(name, "credential_sources.1.mapping_valid", "true"),
+					resource.TestCheckResourceAttr(name, "credential_sources.1.mapping_error", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestCredentialSourceMapping(t *testing.T) {
+	tests := []struct {
+		name           string
+		targetType     string
+		purpose        string
+		sourceType     string
+		credentialType string
+		overrides      map[string]interface{}
+		want           map[string]string
+		err            string
+	}{
+		{
+			name:       "untyped brokered",
+			targetType: targetTypeTcp,
+			purpose:    credentialPurposeBrokered,
+			sourceType: credentialLibraryVaultType,
+			want:       map[string]string{},
+		},
+		{
+			name:           "defaults",
+			targetType:     targetTypeSsh,
+			purpose:        credentialPurposeInjectedApplication,
+			sourceType:     credentialLibraryVaultType,
+			credentialType: credentialTypeSshPrivateKey,
+			want: map[string]string{
+				"username_attribute":               "username",
+				"private_key_attribute":            "private_key",
+				"private_key_passphrase_attribute": "private_key_passphrase",
+			},
+		},
+		{
+			name:           "overridden",
+			targetType:     targetTypeTcp,
+			purpose:        credentialPurposeBrokered,
+			sourceType:     credentialLibraryVaultType,
+			credentialType: credentialTypeUsernamePassword,
+			overrides:      map[string]interface{}{"username_attribute": "user"},
+			want: map[string]string{
+				"username_attribute": "user",
+				"password_attribute": "password",
+			},
+		},
+		{
+			name:           "static credential",
+			targetType:     targetTypeSsh,
+			purpose:        credentialPurposeInjectedApplication,
+			sourceType:     credentialTypeUsernamePassword,
+			credentialType: credentialTypeUsernamePassword,
+			want:           map[string]string{},
+		},
+		{
+			name:       "overrides without type",
+			targetType: targetTypeTcp,
+			purpose:    credentialPurposeBrokered,
+			sourceType: credentialLibraryVaultType,
+			overrides:  map[string]interface{}{"username_attribute": "user"},
+			err:        `credential mapping overrides require one of the credential types "ssh_private_key", "username_password" or "username_password_domain", not ""`,
+		},
+		{
+			name:           "unknown override",
+			targetType:     targetTypeTcp,
+			purpose:        credentialPurposeBrokered,
+			sourceType:     credentialLibraryVaultType,
+			credentialType: credentialTypeUsernamePassword,
+			overrides:      map[string]interface{}{"private_key_attribute": "key"},
+			err:            `"private_key_attribute" is not a mapping attribute of username_password credentials, expected "password_attribute" or "username_attribute"`,
+		},
+		{
+			name:           "injected into tcp",
+			targetType:     targetTypeTcp,
+			purpose:        credentialPurposeInjectedApplication,
+			sourceType:     credentialLibraryVaultType,
+			credentialType: credentialTypeUsernamePassword,
+			err:            "injected application credentials are not supported on tcp targets",
+		},
+		{
+			name:       "untyped injected",
+			targetType: targetTypeSsh,
+			purpose:    credentialPurposeInjectedApplication,
+			sourceType: credentialLibraryVaultType,
+			err:        `untyped credentials cannot be injected into ssh targets, expected "username_password", "ssh_private_key" or "ssh_certificate"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := credentialSourceMapping(tt.targetType, tt.purpose, tt.sourceType, tt.credentialType, tt.overrides)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -35,11 +35,11 @@ func dataSourceUser() *schema.Resource {
 				Computed:    true,
 			},
 			ScopeIdKey: {
-				Description:  "The scope ID in which the resource is created. Defaults `global` if unset.",
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "global",
-				ValidateFunc: validation.StringIsNotEmpty,
+				Description:      "The scope ID in which the resource is created. Defaults `global` if unset.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "global",
+				ValidateDiagFunc: validation.AllDiag(validation.ToDiagFunc(validation.StringIsNotEmpty), validateId(orgScopeIds...)),
 			},
 			userAccountIDsKey: {
 				Description: "Account ID's to associate with this user resource.",
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/api/accounts"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/api/credentialstores"
+	"github.com/hashicorp/boundary/api/groups"
+	"github.com/hashicorp/boundary/api/hostcatalogs"
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/api/managedgroups"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/api/users"
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	deletionProtectionKey = "deletion_protection"
+	forceDestroyKey       = "force_destroy"
+)
+
+func deletionProtectionSchema(kind string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("When true, the %s cannot be deleted, including when a change forces it to be replaced. "+
+			"The attribute must be set to false and applied before the %s can be deleted.", kind, kind),
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+}
+
+func forceDestroySchema(kind string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("When true, the %s is deleted even if it still contains child resources, which are "+
+			"deleted along with it. Otherwise deleting it fails while it is not empty.", kind),
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+}
+
+// childCount is the number of child resources of one type in a container.
+type childCount struct {
+	name  string
+	count int
+}
+
+// childCounter lists the child resources of the container with the given ID.
+type childCounter func(ctx context.Context, md *metaData, id string) ([]childCount, error)
+
+// checkDeletionProtection returns an error diagnostic if the resource has
+// deletion protection enabled, or if it still contains child resources and
+// force_destroy is not set.
+func checkDeletionProtection(ctx context.Context, md *metaData, d *schema.ResourceData, kind string, counter childCounter) diag.Diagnostics {
+	return checkDeletionProtectionOf(ctx, md, kind, d.Id(), d.Get(deletionProtectionKey).(bool), d.Get(forceDestroyKey).(bool), counter)
+}
+
+// checkDeletionProtectionOf is like checkDeletionProtection for a resource
+// that is not the one of the ResourceData, such as the nested projects of a
+// scope tree.
+func checkDeletionProtectionOf(ctx context.Context, md *metaData, kind, id string, protected, force bool, counter childCounter) diag.Diagnostics {
+	if protected {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s %s has deletion protection enabled", kind, id),
+			Detail:   fmt.Sprintf("Set %q to false and apply before deleting the %s.", deletionProtectionKey, kind),
+		}}
+	}
+	if force {
+		return nil
+	}
+
+	counts, err := counter(ctx, md, id)
+	if err != nil {
+		return diag.Errorf("error listing child resources of %s %s: %v", kind, id, err)
+	}
+	var total int
+	var parts []string
+	for _, c := range counts {
+		if c.count == 0 {
+			continue
+		}
+		total += c.count
+		parts = append(parts, fmt.Sprintf("%d %s", c.count, c.name))
+	}
+	if total == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("%s %s still contains %d child resources", kind, id, total),
+		Detail: fmt.Sprintf("The %s contains %s, which would be deleted along with it. Set %q to true and apply "+
+			"to delete it anyway.", kind, strings.Join(parts, ", "), forceDestroyKey),
+	}}
+}
+
+// countScopeChildren counts the resources a scope deletion cascades to. Roles
+// are not counted, since every scope usually has some.
+func countScopeChildren(ctx context.Context, md *metaData, id string) ([]childCount, error) {
+	listers := []struct {
+		name string
+		list func() (int, error)
+	}{
+		{"scopes", func() (int, error) {
+			r, err := scopes.NewClient(md.client).List(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return len(r.GetItems()), nil
+		}},
+		{"auth methods", func() (int, error) {
+			r, err := authmethods.NewClient(md.client).List(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return len(r.GetItems()), nil
+		}},
+		{"users", func() (int, error) {
+			r, err := users.NewClient(md.client).List(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return len(r.GetItems()), nil
+		}},
+		{"groups", func() (int, error) {
+			r, err := groups.NewClient(md.client).List(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return len(r.GetItems()), nil
+		}},
+		{"host catalogs", func() (int, error) {
+			r, err := hostcatalogs.NewClient(md.client).List(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return len(r.GetItems()), nil
+		}},
+		{"credential stores", func() (int, error) {
+			r, err := credentialstores.NewClient(md.client).List(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return len(r.GetItems()), nil
+		}},
+		{"targets", func() (int, error) {
+			r, err := targets.NewClient(md.client).List(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return len(r.GetItems()), nil
+		}},
+	}
+
+	counts := make([]childCount, 0, len(listers))
+	for _, l := range listers {
+		n, err := l.list()
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s: %w", l.name, err)
+		}
+		counts = append(counts, childCount{name: l.name, count: n})
+	}
+	return counts, nil
+}
+
+func countHostCatalogStaticChildren(ctx context.Context, md *metaData, id string) ([]childCount, error) {
+	counts, err := countHostCatalogPluginChildren(ctx, md, id)
+	if err != nil {
+		return nil, err
+	}
+	hr, err := hosts.NewClient(md.client).List(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error listing hosts: %w", err)
+	}
+	return append(counts, childCount{name: "hosts", count: len(hr.GetItems())}), nil
+}
+
+// countHostCatalogPluginChildren only counts host sets, since the hosts of a
+// plugin host catalog are synced from the plugin.
+func countHostCatalogPluginChildren(ctx context.Context, md *metaData, id string) ([]childCount, error) {
+	hsr, err := hostsets.NewClient(md.client).List(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error listing host sets: %w", err)
+	}
+	return []childCount{{name: "host sets", count: len(hsr.GetItems())}}, nil
+}
+
+func countCredentialStoreVaultChildren(ctx context.Context, md *metaData, id string) ([]childCount, error) {
+	clr, err := credentiallibraries.NewClient(md.client).List(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error listing credential libraries: %w", err)
+	}
+	return []childCount{{name: "credential libraries", count: len(clr.GetItems())}}, nil
+}
+
+func countCredentialStoreStaticChildren(ctx context.Context, md *metaData, id string) ([]childCount, error) {
+	cr, err := credentials.NewClient(md.client).List(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error listing credentials: %w", err)
+	}
+	return []childCount{{name: "credentials", count: len(cr.GetItems())}}, nil
+}
+
+// countAuthMethodChildren counts accounts, and managed groups for the auth
+// method types that support them.
+func countAuthMethodChildren(ctx context.Context, md *metaData, id string) ([]childCount, error) {
+	ar, err := accounts.NewClient(md.client).List(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error listing accounts: %w", err)
+	}
+	counts := []childCount{{name: "accounts", count: len(ar.GetItems())}}
+	if strings.HasPrefix(id, globals.PasswordAuthMethodPrefix+"_") {
+		return counts, nil
+	}
+	mgr, err := managedgroups.NewClient(md.client).List(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error listing managed groups: %w", err)
+	}
+	return append(counts, childCount{name: "managed groups", count: len(mgr.GetItems())}), nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// parseDuration parses a duration string such as "8h", "90m" or "3600", a bare
+// number being a number of seconds, into a whole number of seconds.
+func parseDuration(s string) (int64, error) {
+	d, err := parseutil.ParseDurationSecond(s)
+	if err != nil {
+		return 0, err
+	}
+	if d%time.Second != 0 {
+		return 0, fmt.Errorf("%q is not a whole number of seconds", s)
+	}
+	return int64(d / time.Second), nil
+}
+
+// formatSeconds formats a number of seconds as a duration string.
+func formatSeconds(seconds int64) string {
+	return (time.Duration(seconds) * time.Second).String()
+}
+
+// validateDuration returns a ValidateDiagFunc checking that a duration string
+// is between min and max seconds.
+func validateDuration(min, max int64) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		s, ok := i.(string)
+		if !ok || s == "" {
+			return nil
+		}
+		seconds, err := parseDuration(s)
+		if err != nil {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "invalid duration",
+				Detail:        fmt.Sprintf("%v. Expected a duration such as \"8h\", \"90m\" or \"3600s\".", err),
+				AttributePath: path,
+			}}
+		}
+		if seconds < min || seconds > max {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "duration out of range",
+				Detail:        fmt.Sprintf("%q must be between %s and %s.", s, formatSeconds(min), formatSeconds(max)),
+				AttributePath: path,
+			}}
+		}
+		return nil
+	}
+}
+
+// suppressEquivalentDuration suppresses the diff between duration strings of
+// the same length, such as "8h" and "8h0m0s".
+func suppressEquivalentDuration(_, o, n string, _ *schema.ResourceData) bool {
+	oldSeconds, err := parseDuration(o)
+	if err != nil {
+		return false
+	}
+	newSeconds, err := parseDuration(n)
+	if err != nil {
+		return false
+	}
+	return oldSeconds == newSeconds
+}
+
+// validateSessionMaxSeconds validates the maximum duration of the sessions of
+// a target, which Boundary stores as a positive 32 bit number of seconds.
+func validateSessionMaxSeconds(i interface{}, path cty.Path) diag.Diagnostics {
+	v, ok := i.(int)
+	if !ok || (v > 0 && int64(v) <= math.MaxUint32) {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "invalid session_max_seconds",
+		Detail: fmt.Sprintf("session_max_seconds must be between 1 and %d, got %d. Sessions cannot be unlimited, "+
+			"omit session_max_seconds to use the default of 8 hours.", uint32(math.MaxUint32), v),
+		AttributePath: path,
+	}}
+}
+
+// validateSessionConnectionLimit validates the number of connections allowed
+// in the sessions of a target, where -1 means unlimited.
+func validateSessionConnectionLimit(i interface{}, path cty.Path) diag.Diagnostics {
+	v, ok := i.(int)
+	if !ok || v == -1 || (v > 0 && v <= math.MaxInt32) {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "invalid session_connection_limit",
+		Detail: fmt.Sprintf("session_connection_limit must be -1 or between 1 and %d, got %d. Use -1 to allow an "+
+			"unlimited number of connections per session.", math.MaxInt32, v),
+		AttributePath: path,
+	}}
+}
+
+// validateSyncIntervalSeconds validates the sync interval of a plugin host
+// set, where -1 disables syncing and 0 uses the default interval.
+func validateSyncIntervalSeconds(i interface{}, path cty.Path) diag.Diagnostics {
+	v, ok := i.(int)
+	switch {
+	case !ok || (v > 0 && v <= math.MaxInt32) || v == -1:
+		return nil
+	case v == 0:
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "sync_interval_seconds is 0",
+			Detail: "A sync interval of 0 is the same as omitting sync_interval_seconds, which uses the default " +
+				"interval of the controller. Use -1 to disable automatic syncing.",
+			AttributePath: path,
+		}}
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "invalid sync_interval_seconds",
+		Detail: fmt.Sprintf("sync_interval_seconds must be -1 or between 1 and %d, got %d. Use -1 to disable "+
+			"automatic syncing.", math.MaxInt32, v),
+		AttributePath: path,
+	}}
+}
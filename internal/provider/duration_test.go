@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int64
+		err  string
+	}{
+		{in: "8h", want: 28800},
+		{in: "1h30m", want: 5400},
+		{in: "3600", want: 3600},
+		{in: "3600s", want: 3600},
+		{in: "1500ms", err: `"1500ms" is not a whole number of seconds`},
+		{in: "eight hours", err: "time: invalid duration"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseDuration(tt.in)
+			if tt.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	assert.Equal(t, "8h0m0s", formatSeconds(28800))
+	assert.True(t, suppressEquivalentDuration("", "8h0m0s", "480m", nil))
+	assert.False(t, suppressEquivalentDuration("", "8h0m0s", "7h", nil))
+}
+
+func TestDurationValidators(t *testing.T) {
+	path := cty.GetAttrPath("test")
+	// tooLarge is not a constant so that the test builds on 32 bit targets,
+	// where it wraps around to 0 which is invalid too.
+	tooLarge := int64(math.MaxUint32) + 1
+	tests := []struct {
+		name     string
+		validate schema.SchemaValidateDiagFunc
+		in       interface{}
+		severity diag.Severity
+		summary  string
+	}{
+		{name: "duration", validate: validateDuration(1, math.MaxUint32), in: "8h"},
+		{name: "duration unset", validate: validateDuration(1, math.MaxUint32), in: ""},
+		{name: "duration zero", validate: validateDuration(1, math.MaxUint32), in: "0s", summary: "duration out of range"},
+		{name: "duration invalid", validate: validateDuration(1, math.MaxUint32), in: "8 hours", summary: "invalid duration"},
+		{name: "session max seconds", validate: validateSessionMaxSeconds, in: 28800},
+		{name: "session max seconds negative", validate: validateSessionMaxSeconds, in: -1, summary: "invalid session_max_seconds"},
+		{name: "session max seconds too large", validate: validateSessionMaxSeconds, in: int(tooLarge), summary: "invalid session_max_seconds"},
+		{name: "connection limit unlimited", validate: validateSessionConnectionLimit, in: -1},
+		{name: "connection limit", validate: validateSessionConnectionLimit, in: 5},
+		{name: "connection limit zero", validate: validateSessionConnectionLimit, in: 0, summary: "invalid session_connection_limit"},
+		{name: "sync interval disabled", validate: validateSyncIntervalSeconds, in: -1},
+		{name: "sync interval", validate: validateSyncIntervalSeconds, in: 60},
+		{name: "sync interval zero", validate: validateSyncIntervalSeconds, in: 0, severity: diag.Warning, summary: "sync_interval_seconds is 0"},
+		{name: "sync interval invalid", validate: validateSyncIntervalSeconds, in: -2, summary: "invalid sync_interval_seconds"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := tt.validate(tt.in, path)
+			if tt.summary == "" {
+				assert.Empty(t, diags)
+				return
+			}
+			require.Len(t, diags, 1)
+			assert.Equal(t, tt.severity, diags[0].Severity)
+			assert.Equal(t, tt.summary, diags[0].Summary)
+			assert.Equal(t, path, diags[0].AttributePath)
+		})
+	}
+}
+
+func TestTargetSessionMaxSeconds(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceTarget().Schema, map[string]interface{}{
+		targetSessionMaxDurationKey: "2h",
+	})
+	seconds, ok, err := targetSessionMaxSeconds(d)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 7200, seconds)
+
+	d = schema.TestResourceDataRaw(t, resourceTarget().Schema, map[string]interface{}{
+		targetSessionMaxSecondsKey: 600,
+	})
+	seconds, ok, err = targetSessionMaxSeconds(d)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 600, seconds)
+
+	d = schema.TestResourceDataRaw(t, resourceTarget().Schema, map[string]interface{}{})
+	_, ok, err = targetSessionMaxSeconds(d)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"net/netip"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/api/sessions"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const (
+	targetSessionTargetIdKey       = "target_id"
+	targetSessionHostIdKey         = "host_id"
+	targetSessionListenAddressKey  = "listen_address"
+	targetSessionListenPortKey     = "listen_port"
+	targetSessionSessionIdKey      = "session_id"
+	targetSessionExpirationKey     = "expiration"
+	targetSessionAddressKey        = "address"
+	targetSessionPortKey           = "port"
+	targetSessionCredentialsKey    = "credentials"
+	targetSessionSourceIdKey       = "credential_source_id"
+	targetSessionSourceNameKey     = "credential_source_name"
+	targetSessionCredentialTypeKey = "credential_type"
+	targetSessionUsernameKey       = "username"
+	targetSessionPasswordKey       = "password"
+	targetSessionPrivateKeyKey     = "private_key"
+	targetSessionCredentialJsonKey = "credential_json"
+
+	targetSessionDefaultListenAddress = "127.0.0.1"
+
+	// targetSessionCloseTimeout bounds the time waited for the proxy to tear
+	// down the session when the ephemeral resource is closed.
+	targetSessionCloseTimeout = 30 * time.Second
+)
+
+var targetSessionCredentialType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		targetSessionSourceIdKey:       tftypes.String,
+		targetSessionSourceNameKey:     tftypes.String,
+		targetSessionCredentialTypeKey: tftypes.String,
+		targetSessionUsernameKey:       tftypes.String,
+		targetSessionPasswordKey:       tftypes.String,
+		targetSessionPrivateKeyKey:     tftypes.String,
+		targetSessionCredentialJsonKey: tftypes.String,
+	},
+}
+
+// ephemeralTargetSession authorizes a session on a target and proxies it
+// through a local listener until the ephemeral resource is closed.
+type ephemeralTargetSession struct {
+	mu sync.Mutex
+	// proxies holds the running proxies by session ID.
+	proxies map[string]*targetSessionProxy
+}
+
+type targetSessionProxy struct {
+	cancel context.CancelFunc
+	done   chan error
+}
+
+func newEphemeralTargetSession() *ephemeralTargetSession {
+	return &ephemeralTargetSession{
+		proxies: make(map[string]*targetSessionProxy),
+	}
+}
+
+func (r *ephemeralTargetSession) Schema() *tfprotov5.Schema {
+	attr := func(name string, typ tftypes.Type, desc string) *tfprotov5.SchemaAttribute {
+		return &tfprotov5.SchemaAttribute{
+			Name:            name,
+			Type:            typ,
+			Description:     desc,
+			DescriptionKind: tfprotov5.StringKindMarkdown,
+		}
+	}
+	required := func(a *tfprotov5.SchemaAttribute) *tfprotov5.SchemaAttribute { a.Required = true; return a }
+	optional := func(a *tfprotov5.SchemaAttribute) *tfprotov5.SchemaAttribute { a.Optional = true; return a }
+	computed := func(a *tfprotov5.SchemaAttribute) *tfprotov5.SchemaAttribute { a.Computed = true; return a }
+
+	credentials := computed(attr(targetSessionCredentialsKey, tftypes.List{ElementType: targetSessionCredentialType},
+		"The credentials brokered for the session. Each one has the `credential_source_id`, `credential_source_name` "+
+			"and `credential_type` of its source, the `username`, `password` and `private_key` of the credential "+
+			"when it has them, and the full credential encoded as JSON in `credential_json`."))
+	credentials.Sensitive = true
+
+	return &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Description: "The target session ephemeral resource authorizes a session on a Boundary target and proxies it " +
+				"through a local TCP listener, so that other providers can connect to the target during a run. The " +
+				"session is cancelled and the listener closed when Terraform releases the ephemeral resource.",
+			DescriptionKind: tfprotov5.StringKindMarkdown,
+			Attributes: []*tfprotov5.SchemaAttribute{
+				required(attr(targetSessionTargetIdKey, tftypes.String, "The ID of the target to connect to.")),
+				optional(attr(targetSessionHostIdKey, tftypes.String,
+					"The ID of the host to connect to, when the target has host sources. Defaults to a random host.")),
+				optional(attr(targetSessionListenAddressKey, tftypes.String,
+					fmt.Sprintf("The local IP address to listen on. Defaults to `%s`.", targetSessionDefaultListenAddress))),
+				optional(attr(targetSessionListenPortKey, tftypes.Number,
+					"The local port to listen on. Defaults to the default client port of the target, or to a random port.")),
+				computed(attr(targetSessionSessionIdKey, tftypes.String, "The ID of the session.")),
+				computed(attr(targetSessionExpirationKey, tftypes.String, "The time the session expires at, in RFC 3339 format.")),
+				computed(attr(targetSessionAddressKey, tftypes.String, "The IP address the local listener is bound to.")),
+				computed(attr(targetSessionPortKey, tftypes.Number, "The port the local listener is bound to.")),
+				credentials,
+			},
+		},
+	}
+}
+
+func (r *ephemeralTargetSession) Validate(config map[string]tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags = append(diags, validateEphemeralString(config, targetSessionTargetIdKey, validateId(targetIds))...)
+	diags = append(diags, validateEphemeralString(config, targetSessionHostIdKey, validateId(hostIds...))...)
+	if addr, ok := ephemeralString(config, targetSessionListenAddressKey); ok {
+		if _, err := netip.ParseAddr(addr); err != nil {
+			diags = append(diags, diag.Errorf("%q must be an IP address: %v", targetSessionListenAddressKey, err)...)
+		}
+	}
+	if port, ok := ephemeralInt(config, targetSessionListenPortKey); ok && (port < 0 || port > math.MaxUint16) {
+		diags = append(diags, diag.Errorf("%q must be a valid tcp port", targetSessionListenPortKey)...)
+	}
+	return diags
+}
+
+func (r *ephemeralTargetSession) Open(ctx context.Context, md *metaData, config map[string]tftypes.Value) (map[string]tftypes.Value, []byte, diag.Diagnostics) {
+	if diags := r.Validate(config); diags.HasError() {
+		return nil, nil, diags
+	}
+
+	targetId, _ := ephemeralString(config, targetSessionTargetIdKey)
+	var opts []targets.Option
+	if hostId, ok := ephemeralString(config, targetSessionHostIdKey); ok {
+		opts = append(opts, targets.WithHostId(hostId))
+	}
+
+	listenAddr := targetSessionDefaultListenAddress
+	if addr, ok := ephemeralString(config, targetSessionListenAddressKey); ok {
+		listenAddr = addr
+	}
+	listenPort, _ := ephemeralInt(config, targetSessionListenPortKey)
+	addrPort := netip.AddrPortFrom(netip.MustParseAddr(listenAddr), uint16(listenPort))
+
+	tc := targets.NewClient(md.client)
+	sar, err := tc.AuthorizeSession(ctx, targetId, opts...)
+	if err != nil {
+		return nil, nil, diag.Errorf("error authorizing session: %v", err)
+	}
+	if sar == nil || sar.Item == nil {
+		return nil, nil, diag.Errorf("session authorization nil after authorize")
+	}
+	sa := sar.Item
+
+	// From here on the session is cancelled on errors, so that it does not
+	// stay pending until it expires.
+	fail := func(diags diag.Diagnostics) (map[string]tftypes.Value, []byte, diag.Diagnostics) {
+		return nil, nil, append(diags, cancelTargetSession(ctx, md.client, sa.SessionId)...)
+	}
+
+	credentials, err := targetSessionCredentials(sa.Credentials)
+	if err != nil {
+		return fail(diag.Errorf("error reading session credentials: %v", err))
+	}
+
+	// The proxy outlives the request opening the ephemeral resource, it is
+	// stopped by Close.
+	proxyCtx, cancel := context.WithCancel(context.Background())
+	p, err := proxy.New(proxyCtx, sa.AuthorizationToken, proxy.WithListenAddrPort(addrPort), proxy.WithApiClient(md.client))
+	if err != nil {
+		cancel()
+		return fail(diag.Errorf("error creating session proxy: %v", err))
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- p.Start()
+	}()
+
+	listenerAddr := p.ListenerAddress(ctx)
+	if listenerAddr == "" {
+		cancel()
+		return fail(diag.Errorf("error starting session proxy: %v", errors.Join(ctx.Err(), <-done)))
+	}
+	host, port, err := net.SplitHostPort(listenerAddr)
+	if err != nil {
+		cancel()
+		<-done
+		return fail(diag.Errorf("error parsing session proxy address %q: %v", listenerAddr, err))
+	}
+	portInt, err := strconv.ParseInt(port, 10, 64)
+	if err != nil {
+		cancel()
+		<-done
+		return fail(diag.Errorf("error parsing session proxy address %q: %v", listenerAddr, err))
+	}
+
+	r.mu.Lock()
+	r.proxies[sa.SessionId] = &targetSessionProxy{cancel: cancel, done: done}
+	r.mu.Unlock()
+
+	result := map[string]tftypes.Value{
+		targetSessionTargetIdKey:      config[targetSessionTargetIdKey],
+		targetSessionHostIdKey:        config[targetSessionHostIdKey],
+		targetSessionListenAddressKey: config[targetSessionListenAddressKey],
+		targetSessionListenPortKey:    config[targetSessionListenPortKey],
+		targetSessionSessionIdKey:     tftypes.NewValue(tftypes.String, sa.SessionId),
+		targetSessionExpirationKey:    tftypes.NewValue(tftypes.String, sa.Expiration.Format(time.RFC3339)),
+		targetSessionAddressKey:       tftypes.NewValue(tftypes.String, host),
+		targetSessionPortKey:          tftypes.NewValue(tftypes.Number, big.NewFloat(float64(portInt))),
+		targetSessionCredentialsKey:   tftypes.NewValue(tftypes.List{ElementType: targetSessionCredentialType}, credentials),
+	}
+	return result, []byte(sa.SessionId), nil
+}
+
+func (r *ephemeralTargetSession) Close(ctx context.Context, private []byte) diag.Diagnostics {
+	sessionId := string(private)
+	r.mu.Lock()
+	p, ok := r.proxies[sessionId]
+	delete(r.proxies, sessionId)
+	r.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	// Cancelling the proxy closes the listener and cancels the session.
+	p.cancel()
+	select {
+	case err := <-p.done:
+		if err != nil && !errors.Is(err, context.Canceled) {
+			return diag.Errorf("error closing session %q: %v", sessionId, err)
+		}
+	case <-time.After(targetSessionCloseTimeout):
+		return diag.Errorf("timed out closing session %q", sessionId)
+	case <-ctx.Done():
+		return diag.Errorf("error closing session %q: %v", sessionId, ctx.Err())
+	}
+	return nil
+}
+
+// cancelTargetSession cancels a session that could not be proxied. The request
+// opening the ephemeral resource may have been cancelled already, so the
+// session is cancelled with a context of its own.
+func cancelTargetSession(ctx context.Context, client *api.Client, sessionId string) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), targetSessionCloseTimeout)
+	defer cancel()
+	if _, err := sessions.NewClient(client).Cancel(ctx, sessionId, 0, sessions.WithAutomaticVersioning(true)); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("error cancelling session %q", sessionId),
+			Detail:   fmt.Sprintf("The session stays pending until it expires: %v", err),
+		}}
+	}
+	return nil
+}
+
+// targetSessionCredentials returns the credentials brokered for a session.
+// Credentials of a known type have their fields in Credential, the others are
+// only available as the decoded secret.
+func targetSessionCredentials(creds []*targets.SessionCredential) ([]tftypes.Value, error) {
+	values := make([]tftypes.Value, 0, len(creds))
+	for _, c := range creds {
+		fields := c.Credential
+		if len(fields) == 0 && c.Secret != nil {
+			fields = c.Secret.Decoded
+		}
+		encoded, err := json.Marshal(fields)
+		if err != nil {
+			return nil, err
+		}
+
+		str := func(s string) tftypes.Value {
+			if s == "" {
+				return tftypes.NewValue(tftypes.String, nil)
+			}
+			return tftypes.NewValue(tftypes.String, s)
+		}
+		field := func(key string) tftypes.Value {
+			s, _ := fields[key].(string)
+			return str(s)
+		}
+
+		var source targets.CredentialSource
+		if c.CredentialSource != nil {
+			source = *c.CredentialSource
+		}
+		values = append(values, tftypes.NewValue(targetSessionCredentialType, map[string]tftypes.Value{
+			targetSessionSourceIdKey:       str(source.Id),
+			targetSessionSourceNameKey:     str(source.Name),
+			targetSessionCredentialTypeKey: str(source.CredentialType),
+			targetSessionUsernameKey:       field("username"),
+			targetSessionPasswordKey:       field("password"),
+			targetSessionPrivateKeyKey:     field("private_key"),
+			targetSessionCredentialJsonKey: tftypes.NewValue(tftypes.String, string(encoded)),
+		}))
+	}
+	return values, nil
+}
@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	fooTargetForSession = `
+resource "boundary_target" "foo" {
+	type         = "tcp"
+	name         = "test"
+	default_port = 22
+	address      = "127.0.0.1"
+	scope_id     = boundary_scope.proj1.id
+	depends_on   = [boundary_role.proj1_admin]
+}`
+
+	fooTargetSession = `
+ephemeral "boundary_target_session" "foo" {
+	target_id = boundary_target.foo.id
+}`
+)
+
+// targetSessionConfig encodes the configuration of a target session ephemeral
+// resource, leaving the attributes that are not given null.
+func targetSessionConfig(attrs map[string]tftypes.Value) (*tfprotov5.DynamicValue, error) {
+	typ := newEphemeralTargetSession().Schema().ValueType().(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+		if v, ok := attrs[name]; ok {
+			values[name] = v
+		}
+	}
+	dv, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, values))
+	if err != nil {
+		return nil, err
+	}
+	return &dv, nil
+}
+
+func TestProtocolServerEphemeralResources(t *testing.T) {
+	ctx := context.Background()
+	s := newProtocolServer(New())
+
+	metadata, err := s.GetMetadata(ctx, &tfprotov5.GetMetadataRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, metadata.EphemeralResources, tfprotov5.EphemeralResourceMetadata{TypeName: "boundary_target_session"})
+	assert.NotEmpty(t, metadata.Resources)
+
+	schemas, err := s.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, schemas.EphemeralResourceSchemas, "boundary_target_session")
+	assert.Contains(t, schemas.ResourceSchemas, "boundary_target")
+
+	tests := []struct {
+		name  string
+		attrs map[string]tftypes.Value
+		err   string
+	}{
+		{
+			name: "valid",
+			attrs: map[string]tftypes.Value{
+				targetSessionTargetIdKey:      tftypes.NewValue(tftypes.String, "ttcp_1234567890"),
+				targetSessionListenAddressKey: tftypes.NewValue(tftypes.String, "::1"),
+				targetSessionListenPortKey:    tftypes.NewValue(tftypes.Number, 5432),
+			},
+		},
+		{
+			name: "unknown",
+			attrs: map[string]tftypes.Value{
+				targetSessionTargetIdKey: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				targetSessionHostIdKey:   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+		{
+			name: "host set as target",
+			attrs: map[string]tftypes.Value{
+				targetSessionTargetIdKey: tftypes.NewValue(tftypes.String, "hsst_1234567890"),
+			},
+			err: "expected the ID of a target",
+		},
+		{
+			name: "invalid address",
+			attrs: map[string]tftypes.Value{
+				targetSessionTargetIdKey:      tftypes.NewValue(tftypes.String, "ttcp_1234567890"),
+				targetSessionListenAddressKey: tftypes.NewValue(tftypes.String, "localhost"),
+			},
+			err: `"listen_address" must be an IP address`,
+		},
+		{
+			name: "invalid port",
+			attrs: map[string]tftypes.Value{
+				targetSessionTargetIdKey:   tftypes.NewValue(tftypes.String, "ttcp_1234567890"),
+				targetSessionListenPortKey: tftypes.NewValue(tftypes.Number, 70000),
+			},
+			err: `"listen_port" must be a valid tcp port`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := targetSessionConfig(tt.attrs)
+			require.NoError(t, err)
+			resp, err := s.ValidateEphemeralResourceConfig(ctx, &tfprotov5.ValidateEphemeralResourceConfigRequest{
+				TypeName: "boundary_target_session",
+				Config:   config,
+			})
+			require.NoError(t, err)
+			if tt.err == "" {
+				assert.Empty(t, resp.Diagnostics)
+				return
+			}
+			require.Len(t, resp.Diagnostics, 1)
+			assert.Equal(t, tfprotov5.DiagnosticSeverityError, resp.Diagnostics[0].Severity)
+			assert.Contains(t, resp.Diagnostics[0].Summary, tt.err)
+		})
+	}
+}
+
+// TestAccEphemeralTargetSession opens a target session with an ephemeral
+// block, which requires Terraform 1.10 or later. The test controller runs
+// without a worker, so the session cannot be authorized. The proxy, the
+// brokered credentials and the cancellation of the session by Close are not
+// covered by acceptance tests since the test packages of Boundary do not
+// provide a worker, they are covered by the unit tests below.
+func TestAccEphemeralTargetSession(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(&provider),
+		CheckDestroy:             testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooTargetForSession),
+				Check:  testAccCheckTargetResourceExists(provider, "boundary_target.foo"),
+			},
+			{
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fooTargetForSession, fooTargetSession),
+				ExpectError: regexp.MustCompile(`No workers are available`),
+			},
+		},
+	})
+}
+
+func TestEphemeralTargetSessionClose(t *testing.T) {
+	ctx := context.Background()
+
+	// startProxy registers a proxy that closes its listener when cancelled and
+	// then returns err.
+	startProxy := func(t *testing.T, r *ephemeralTargetSession, sessionId string, err error) net.Listener {
+		l, lErr := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, lErr)
+		proxyCtx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() {
+			<-proxyCtx.Done()
+			l.Close()
+			done <- err
+		}()
+		r.proxies[sessionId] = &targetSessionProxy{cancel: cancel, done: done}
+		return l
+	}
+
+	t.Run("closes the listener", func(t *testing.T) {
+		r := newEphemeralTargetSession()
+		l := startProxy(t, r, "s_1234567890", context.Canceled)
+
+		assert.Empty(t, r.Close(ctx, []byte("s_1234567890")))
+		assert.Empty(t, r.proxies)
+		_, err := net.Dial("tcp", l.Addr().String())
+		assert.Error(t, err)
+
+		// closing it again is a no-op
+		assert.Empty(t, r.Close(ctx, []byte("s_1234567890")))
+	})
+
+	t.Run("proxy error", func(t *testing.T) {
+		r := newEphemeralTargetSession()
+		startProxy(t, r, "s_1234567890", errors.New("connection reset"))
+		other := startProxy(t, r, "s_0987654321", context.Canceled)
+
+		diags := r.Close(ctx, []byte("s_1234567890"))
+		require.Len(t, diags, 1)
+		assert.Equal(t, `error closing session "s_1234567890": connection reset`, diags[0].Summary)
+
+		// other sessions keep running
+		require.Contains(t, r.proxies, "s_0987654321")
+		conn, err := net.Dial("tcp", other.Addr().String())
+		require.NoError(t, err)
+		conn.Close()
+		assert.Empty(t, r.Close(ctx, []byte("s_0987654321")))
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		r := newEphemeralTargetSession()
+		assert.Empty(t, r.Close(ctx, []byte("s_1234567890")))
+	})
+}
+
+func TestTargetSessionCredentials(t *testing.T) {
+	got, err := targetSessionCredentials([]*targets.SessionCredential{
+		{
+			CredentialSource: &targets.CredentialSource{Id: "csst_1234567890", Name: "db", CredentialType: "username_password"},
+			Credential:       map[string]interface{}{"username": "admin", "password": "secret"},
+			Secret:           &targets.SessionSecret{Decoded: map[string]interface{}{"ignored": true}},
+		},
+		{
+			CredentialSource: &targets.CredentialSource{Id: "clvlt_1234567890", CredentialType: "ssh_private_key"},
+			Credential:       map[string]interface{}{"username": "ubuntu", "private_key": "-----BEGIN KEY-----"},
+		},
+		{
+			// credentials of an unknown type are only decoded
+			CredentialSource: &targets.CredentialSource{Id: "clvlt_0987654321"},
+			Secret:           &targets.SessionSecret{Decoded: map[string]interface{}{"token": "abc", "ttl": 60}},
+		},
+		{},
+	})
+	require.NoError(t, err)
+
+	decoded := make([]map[string]*string, 0, len(got))
+	for _, v := range got {
+		var attrs map[string]tftypes.Value
+		require.NoError(t, v.As(&attrs))
+		fields := map[string]*string{}
+		for key, attr := range attrs {
+			var s *string
+			require.NoError(t, attr.As(&s))
+			fields[key] = s
+		}
+		decoded = append(decoded, fields)
+	}
+
+	str := func(s string) *string { return &s }
+	assert.Equal(t, []map[string]*string{
+		{
+			targetSessionSourceIdKey:       str("csst_1234567890"),
+			targetSessionSourceNameKey:     str("db"),
+			targetSessionCredentialTypeKey: str("username_password"),
+			targetSessionUsernameKey:       str("admin"),
+			targetSessionPasswordKey:       str("secret"),
+			targetSessionPrivateKeyKey:     nil,
+			targetSessionCredentialJsonKey: str(`{"password":"secret","username":"admin"}`),
+		},
+		{
+			targetSessionSourceIdKey:       str("clvlt_1234567890"),
+			targetSessionSourceNameKey:     nil,
+			targetSessionCredentialTypeKey: str("ssh_private_key"),
+			targetSessionUsernameKey:       str("ubuntu"),
+			targetSessionPasswordKey:       nil,
+			targetSessionPrivateKeyKey:     str("-----BEGIN KEY-----"),
+			targetSessionCredentialJsonKey: str(`{"private_key":"-----BEGIN KEY-----","username":"ubuntu"}`),
+		},
+		{
+			targetSessionSourceIdKey:       str("clvlt_0987654321"),
+			targetSessionSourceNameKey:     nil,
+			targetSessionCredentialTypeKey: nil,
+			targetSessionUsernameKey:       nil,
+			targetSessionPasswordKey:       nil,
+			targetSessionPrivateKeyKey:     nil,
+			targetSessionCredentialJsonKey: str(`{"token":"abc","ttl":60}`),
+		},
+		{
+			targetSessionSourceIdKey:       nil,
+			targetSessionSourceNameKey:     nil,
+			targetSessionCredentialTypeKey: nil,
+			targetSessionUsernameKey:       nil,
+			targetSessionPasswordKey:       nil,
+			targetSessionPrivateKeyKey:     nil,
+			targetSessionCredentialJsonKey: str("null"),
+		},
+	}, decoded)
+}
@@ -3,8 +3,116 @@
 
 package provider
 
-import "fmt"
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-bexpr/grammar"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
 
 func FilterWithItemNameMatches(name string) string {
 	return fmt.Sprintf("\"/item/name\" matches \"%s\"", name)
 }
+
+// filterWithItemNameEquals returns a filter matching the items with exactly
+// the given name.
+func filterWithItemNameEquals(name string) string {
+	return fmt.Sprintf("\"/item/name\" == %s", strconv.Quote(name))
+}
+
+// filterErrorRe matches the position go-bexpr prefixes its parse errors with,
+// e.g. `1:9 (8): no match found, expected: "!=", "==" ...`.
+var filterErrorRe = regexp.MustCompile(`^(\d+):(\d+) \(\d+\): (.*)`)
+
+// parseFilter parses a boolean expression filter as Boundary does, returning
+// a diagnostic that points at the position of the syntax error.
+func parseFilter(filter string, path cty.Path) (grammar.Expression, diag.Diagnostics) {
+	ast, err := grammar.Parse("", []byte(filter))
+	if err == nil {
+		return ast.(grammar.Expression), nil
+	}
+
+	// only the first error is reported, the others are follow-ups
+	msg := strings.SplitN(err.Error(), "\n", 2)[0]
+	m := filterErrorRe.FindStringSubmatch(msg)
+	if m == nil {
+		return nil, diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "invalid filter expression",
+			Detail:        msg,
+			AttributePath: path,
+		}}
+	}
+	line, _ := strconv.Atoi(m[1])
+	col, _ := strconv.Atoi(m[2])
+	detail := fmt.Sprintf("Syntax error at line %d, character %d: %s", line, col, m[3])
+	if lines := strings.Split(filter, "\n"); line >= 1 && line <= len(lines) {
+		detail += fmt.Sprintf("\n\n  %s\n  %s^", lines[line-1], strings.Repeat(" ", col-1))
+	}
+	return nil, diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("invalid filter expression at character %d", col),
+		Detail:        detail,
+		AttributePath: path,
+	}}
+}
+
+// validateFilter is a ValidateDiagFunc for boolean expression filters.
+func validateFilter(i interface{}, path cty.Path) diag.Diagnostics {
+	_, diags := parseFilter(i.(string), path)
+	return diags
+}
+
+// validateWorkerFilter is a ValidateDiagFunc for worker filters. In addition
+// to the syntax, it warns about selectors that do not match the data workers
+// expose, which is their name and their tags.
+func validateWorkerFilter(i interface{}, path cty.Path) diag.Diagnostics {
+	ast, diags := parseFilter(i.(string), path)
+	if diags.HasError() {
+		return diags
+	}
+	for _, sel := range filterSelectors(ast) {
+		if isWorkerSelector(sel.Path) {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "unexpected worker filter selector",
+			Detail: fmt.Sprintf("The selector %q does not match the data workers are filtered on, which is "+
+				"\"/name\" and \"/tags/<key>\". The filter may never match a worker.", "/"+strings.Join(sel.Path, "/")),
+			AttributePath: path,
+		})
+	}
+	return diags
+}
+
+func isWorkerSelector(path []string) bool {
+	switch {
+	case len(path) == 1 && path[0] == "name":
+		return true
+	case len(path) >= 2 && path[0] == "tags":
+		return true
+	}
+	return false
+}
+
+// filterSelectors returns the selectors of the expression that refer to the
+// filtered data. Selectors within collection expressions refer to the bound
+// names and are not returned.
+func filterSelectors(expr grammar.Expression) []grammar.Selector {
+	switch e := expr.(type) {
+	case *grammar.UnaryExpression:
+		return filterSelectors(e.Operand)
+	case *grammar.BinaryExpression:
+		return append(filterSelectors(e.Left), filterSelectors(e.Right)...)
+	case *grammar.MatchExpression:
+		return []grammar.Selector{e.Selector}
+	case *grammar.CollectionExpression:
+		return []grammar.Selector{e.Selector}
+	}
+	return nil
+}
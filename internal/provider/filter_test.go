@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateFilter(t *testing.T) {
+	path := cty.GetAttrPath("filter")
+
+	assert.Empty(t, validateFilter(`"/token/groups" contains "admins"`, path))
+
+	diags := validateFilter(`"/name" = "x"`, path)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Error, diags[0].Severity)
+	assert.Equal(t, "invalid filter expression at character 9", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "Syntax error at line 1, character 9")
+	assert.Contains(t, diags[0].Detail, "\n  \"/name\" = \"x\"\n          ^")
+	assert.Equal(t, path, diags[0].AttributePath)
+}
+
+func TestValidateWorkerFilter(t *testing.T) {
+	path := cty.GetAttrPath("worker_filter")
+
+	tests := []struct {
+		name     string
+		filter   string
+		warnings int
+		wantErr  bool
+	}{
+		{name: "tags", filter: `"dev" in "/tags/type"`},
+		{name: "name", filter: `"/name" == "worker1" or "/name" matches "prod-.*"`},
+		{name: "collection", filter: `any "/tags/region" as r { r == "us-east-1" }`},
+		{name: "unknown selector", filter: `"dev" in "/labels/type"`, warnings: 1},
+		{name: "bare tags", filter: `"/tags" is not empty and "/name" == "w"`, warnings: 1},
+		{name: "syntax error", filter: `"dev" in "/tags/type" and (`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateWorkerFilter(tt.filter, path)
+			assert.Equal(t, tt.wantErr, diags.HasError())
+			if !tt.wantErr {
+				assert.Len(t, diags, tt.warnings)
+			}
+		})
+	}
+}
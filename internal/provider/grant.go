@@ -0,0 +1,625 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/boundary/globals"
+)
+
+const (
+	grantWildcard = "*"
+
+	grantActionCreate = "create"
+	grantActionList   = "list"
+
+	// grantValidationEnv is the environment variable that, when set to
+	// "warn", reports grants that only fail the checks against
+	// grantResourceTypes as warnings instead of errors. It allows types and
+	// actions added to Boundary after the version the table follows.
+	grantValidationEnv  = "BOUNDARY_GRANT_VALIDATION"
+	grantValidationWarn = "warn"
+)
+
+// grantResourceType describes the actions that can be granted on a Boundary
+// resource type. It mirrors the action sets registered by the controller
+// handlers so that grants can be validated before they are sent to Boundary.
+// TestGrantResourceTypesMatchBoundary checks grantResourceTypes against the
+// handlers of the boundary module in go.mod.
+type grantResourceType struct {
+	// parent is the type of the resource this type lives under, if any
+	parent string
+
+	// actions is the set of actions valid on the type, including both the
+	// actions on individual resources and the collection actions
+	actions []string
+}
+
+var grantResourceTypes = map[string]grantResourceType{
+	"scope": {actions: []string{
+		"no-op", "read", "update", "delete", "attach-storage-policy", "detach-storage-policy",
+		"create", "list", "list-keys", "rotate-keys", "list-key-version-destruction-jobs", "destroy-key-version",
+	}},
+	"user": {actions: []string{
+		"no-op", "read", "update", "delete", "add-accounts", "set-accounts", "remove-accounts",
+		"list-resolvable-aliases", "create", "list",
+	}},
+	"group": {actions: []string{
+		"no-op", "read", "update", "delete", "add-members", "set-members", "remove-members", "create", "list",
+	}},
+	"role": {actions: []string{
+		"no-op", "read", "update", "delete", "add-principals", "set-principals", "remove-principals",
+		"add-grants", "set-grants", "remove-grants", "add-grant-scopes", "set-grant-scopes", "remove-grant-scopes",
+		"create", "list",
+	}},
+	"auth-method": {actions: []string{
+		"no-op", "read", "update", "delete", "authenticate", "change-state", "create", "list",
+	}},
+	"account": {parent: "auth-method", actions: []string{
+		"no-op", "read", "update", "delete", "set-password", "change-password", "create", "list",
+	}},
+	"managed-group": {parent: "auth-method", actions: []string{
+		"no-op", "read", "update", "delete", "create", "list",
+	}},
+	"auth-token": {actions: []string{
+		"no-op", "read", "read:self", "delete", "delete:self", "list",
+	}},
+	"host-catalog": {actions: []string{
+		"no-op", "read", "update", "delete", "create", "list",
+	}},
+	"host-set": {parent: "host-catalog", actions: []string{
+		"no-op", "read", "update", "delete", "add-hosts", "set-hosts", "remove-hosts", "create", "list",
+	}},
+	"host": {parent: "host-catalog", actions: []string{
+		"no-op", "read", "update", "delete", "create", "list",
+	}},
+	"target": {actions: []string{
+		"no-op", "read", "update", "delete", "add-host-sources", "set-host-sources", "remove-host-sources",
+		"add-credential-sources", "set-credential-sources", "remove-credential-sources", "authorize-session",
+		"create", "list",
+	}},
+	"worker": {actions: []string{
+		"no-op", "read", "update", "delete", "add-worker-tags", "set-worker-tags", "remove-worker-tags",
+		"create:controller-led", "create:worker-led", "list", "read-certificate-authority",
+		"reinitialize-certificate-authority",
+	}},
+	"session": {actions: []string{
+		"no-op", "read", "read:self", "cancel", "cancel:self", "list",
+	}},
+	"session-recording": {actions: []string{
+		"no-op", "read", "download", "delete", "reapply-storage-policy", "list",
+	}},
+	"credential-store": {actions: []string{
+		"no-op", "read", "update", "delete", "create", "list",
+	}},
+	"credential-library": {parent: "credential-store", actions: []string{
+		"no-op", "read", "update", "delete", "create", "list",
+	}},
+	"credential": {parent: "credential-store", actions: []string{
+		"no-op", "read", "update", "delete", "create", "list",
+	}},
+	"storage-bucket": {actions: []string{
+		"no-op", "read", "update", "delete", "create", "list",
+	}},
+	"policy": {actions: []string{
+		"no-op", "read", "update", "delete", "create", "list",
+	}},
+	"billing": {actions: []string{
+		"monthly-active-users",
+	}},
+	"alias": {actions: []string{
+		"no-op", "read", "update", "delete", "create", "list",
+	}},
+}
+
+// deprecatedGrantActions maps actions that Boundary still accepts to the
+// action that replaced them.
+var deprecatedGrantActions = map[string]string{
+	"add-host-sets":               "add-host-sources",
+	"set-host-sets":               "set-host-sources",
+	"remove-host-sets":            "remove-host-sources",
+	"add-credential-libraries":    "add-credential-sources",
+	"set-credential-libraries":    "set-credential-sources",
+	"remove-credential-libraries": "remove-credential-sources",
+}
+
+// grantTemplates are the templated ID values Boundary substitutes when the
+// grant is evaluated.
+var grantTemplates = []string{"user.id", ".User.Id", "account.id", ".Account.Id"}
+
+// parsedGrant is the result of parsing a grant string with parseGrant.
+type parsedGrant struct {
+	id              string
+	ids             []string
+	typ             string
+	actions         []string
+	outputFields    []string
+	hasOutputFields bool
+}
+
+// grantError reports a problem with a single component of a grant string.
+type grantError struct {
+	grant     string
+	component string
+	msg       string
+
+	// unsupported is set when the grant refers to a type or action that is
+	// not in grantResourceTypes, which may lag behind the controller
+	unsupported bool
+}
+
+func (e *grantError) Error() string {
+	if e.component == "" {
+		return fmt.Sprintf("invalid grant %q: %s", e.grant, e.msg)
+	}
+	return fmt.Sprintf("invalid grant %q: %q component: %s", e.grant, e.component, e.msg)
+}
+
+// parseGrant parses and validates a grant string following the rules applied
+// by the Boundary controller. Checks that depend on the scope of the role are
+// left to the controller.
+func parseGrant(grantString string) (*parsedGrant, error) {
+	if len(grantString) == 0 {
+		return nil, errors.New("missing grant string")
+	}
+	grantString = strings.ToValidUTF8(grantString, string(unicode.ReplacementChar))
+
+	fail := func(component, format string, a ...any) (*parsedGrant, error) {
+		return nil, &grantError{grant: grantString, component: component, msg: fmt.Sprintf(format, a...)}
+	}
+	unsupported := func(component, format string, a ...any) (*parsedGrant, error) {
+		return nil, &grantError{grant: grantString, component: component, msg: fmt.Sprintf(format, a...), unsupported: true}
+	}
+
+	var g *parsedGrant
+	var err error
+	if grantString[0] == '{' {
+		g, err = parseGrantJSON(grantString)
+	} else {
+		g, err = parseGrantText(grantString)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if g.id != "" && len(g.ids) > 0 {
+		return fail("", `both "id" and "ids" are set`)
+	}
+
+	grantIds := g.ids
+	idComponent := "ids"
+	if g.id != "" {
+		grantIds = []string{g.id}
+		idComponent = "id"
+	}
+	if len(grantIds) > 1 && slices.Contains(grantIds, grantWildcard) {
+		return fail(idComponent, "contains both wildcard and non-wildcard values")
+	}
+
+	var idType string
+	for _, id := range grantIds {
+		if id == grantWildcard {
+			continue
+		}
+		t := grantIdType(id)
+		if t == "" {
+			if strings.HasPrefix(id, "{{") {
+				return fail(idComponent, "unknown template %q", id)
+			}
+			return fail(idComponent, "id %q is of an unknown resource type", id)
+		}
+		if idType != "" && idType != t {
+			return fail(idComponent, "contains ids of differently-typed resources")
+		}
+		idType = t
+	}
+
+	switch g.typ {
+	case "", grantWildcard:
+	default:
+		if _, ok := grantResourceTypes[g.typ]; !ok {
+			return unsupported("type", "unknown type specifier %q", g.typ)
+		}
+	}
+
+	if len(g.actions) == 0 && !g.hasOutputFields {
+		return fail("actions", "missing actions")
+	}
+	for _, a := range g.actions {
+		if _, ok := deprecatedGrantActions[a]; ok {
+			continue
+		}
+		if a != grantWildcard && !grantActionKnown(a) {
+			return unsupported("actions", "unknown action %q", a)
+		}
+	}
+	if len(g.actions) > 1 && slices.Contains(g.actions, grantWildcard) {
+		return fail("actions", "%q cannot be specified with other actions", grantWildcard)
+	}
+
+	hasCollectionAction := slices.Contains(g.actions, grantActionCreate) || slices.Contains(g.actions, grantActionList)
+	switch {
+	case len(grantIds) == 0:
+		switch g.typ {
+		case "":
+			return fail("", "contains no id or type")
+		case grantWildcard:
+			return fail("type", "wildcard type requires an id value")
+		}
+		for _, a := range g.actions {
+			if !grantActionIsOrParent(grantActionCreate, a) && !grantActionIsOrParent(grantActionList, a) {
+				return fail("actions", "action %q is not allowed without an id, only %q and %q are", a, grantActionCreate, grantActionList)
+			}
+		}
+	case grantIds[0] == grantWildcard:
+		if g.typ == "" {
+			return fail("type", "a wildcard id requires a type")
+		}
+	default:
+		switch g.typ {
+		case "":
+			if hasCollectionAction {
+				return fail("actions", "%q and %q actions require a type", grantActionCreate, grantActionList)
+			}
+		case grantWildcard:
+			if !grantTypeHasChildren(idType) {
+				return unsupported("type", "wildcard type is not allowed for ids of type %s, which has no child types", idType)
+			}
+		default:
+			if grantTypeParent(g.typ) != idType {
+				return unsupported("type", "%s is not a child type of the type (%s) of the specified id", g.typ, idType)
+			}
+		}
+	}
+
+	// Ensure the actions make sense for the resource type the grant applies
+	// to, when it can be determined.
+	effectiveType := g.typ
+	if effectiveType == "" {
+		effectiveType = idType
+	}
+	if rt, ok := grantResourceTypes[effectiveType]; ok {
+		for _, a := range g.actions {
+			if a == grantWildcard {
+				continue
+			}
+			if replacement, ok := deprecatedGrantActions[a]; ok {
+				a = replacement
+			}
+			if !slices.ContainsFunc(rt.actions, func(valid string) bool { return grantActionIsOrParent(a, valid) }) {
+				return unsupported("actions", "action %q is not valid for type %s", a, effectiveType)
+			}
+		}
+	}
+
+	return g, nil
+}
+
+func parseGrantText(grantString string) (*parsedGrant, error) {
+	g := &parsedGrant{}
+	fail := func(component, format string, a ...any) (*parsedGrant, error) {
+		return nil, &grantError{grant: grantString, component: component, msg: fmt.Sprintf(format, a...)}
+	}
+
+	for _, segment := range strings.Split(grantString, ";") {
+		kv := strings.Split(segment, "=")
+		switch {
+		case len(kv) != 2:
+			return fail(segment, "wrong number of equal signs")
+		case len(kv[0]) == 0:
+			return fail(segment, "missing key")
+		case len(kv[1]) == 0 && kv[0] != "output_fields":
+			return fail(segment, "missing value")
+		}
+
+		switch kv[0] {
+		case "id":
+			if strings.Contains(kv[1], ",") {
+				return fail(kv[0], "cannot contain a comma")
+			}
+			g.id = kv[1]
+		case "ids":
+			g.ids = strings.Split(kv[1], ",")
+			if slices.Contains(g.ids, "") {
+				return fail(kv[0], "empty ID provided")
+			}
+		case "type":
+			g.typ = strings.ToLower(kv[1])
+		case "actions":
+			for _, a := range strings.Split(kv[1], ",") {
+				if a == "" {
+					return fail(kv[0], "empty action found")
+				}
+				g.actions = append(g.actions, strings.ToLower(a))
+			}
+		case "output_fields":
+			g.hasOutputFields = true
+			if kv[1] != "" {
+				g.outputFields = strings.Split(kv[1], ",")
+			}
+		}
+	}
+
+	return g, nil
+}
+
+func parseGrantJSON(grantString string) (*parsedGrant, error) {
+	g := &parsedGrant{}
+	fail := func(component, format string, a ...any) (*parsedGrant, error) {
+		return nil, &grantError{grant: grantString, component: component, msg: fmt.Sprintf(format, a...)}
+	}
+
+	raw := make(map[string]any, 4)
+	if err := json.Unmarshal([]byte(grantString), &raw); err != nil {
+		return fail("", "unable to parse JSON: %v", err)
+	}
+
+	stringArray := func(key string) ([]string, bool) {
+		rawList, ok := raw[key].([]any)
+		if !ok {
+			return nil, false
+		}
+		out := make([]string, 0, len(rawList))
+		for _, v := range rawList {
+			s, ok := v.(string)
+			if !ok {
+				return nil, false
+			}
+			out = append(out, s)
+		}
+		return out, true
+	}
+
+	for key := range raw {
+		switch key {
+		case "id":
+			id, ok := raw[key].(string)
+			switch {
+			case !ok:
+				return fail(key, "unable to interpret as string")
+			case id == "":
+				return fail(key, "empty ID provided")
+			case strings.ContainsAny(id, ",;="):
+				return fail(key, "cannot contain a comma, semicolon or equals sign")
+			}
+			g.id = id
+		case "ids":
+			ids, ok := stringArray(key)
+			if !ok {
+				return fail(key, "unable to interpret as array of strings")
+			}
+			for _, id := range ids {
+				switch {
+				case id == "":
+					return fail(key, "empty ID provided")
+				case strings.ContainsAny(id, ",;="):
+					return fail(key, "cannot contain a comma, semicolon or equals sign")
+				}
+			}
+			g.ids = ids
+		case "type":
+			typ, ok := raw[key].(string)
+			if !ok {
+				return fail(key, "unable to interpret as string")
+			}
+			g.typ = typ
+		case "actions":
+			actions, ok := stringArray(key)
+			if !ok {
+				return fail(key, "unable to interpret as array of strings")
+			}
+			for _, a := range actions {
+				switch {
+				case a == "":
+					return fail(key, "empty action found")
+				case strings.ContainsAny(a, ",;="):
+					return fail(key, "cannot contain a comma, semicolon or equals sign")
+				}
+				g.actions = append(g.actions, strings.ToLower(a))
+			}
+		case "output_fields":
+			fields, ok := stringArray(key)
+			if !ok {
+				return fail(key, "unable to interpret as array of strings")
+			}
+			g.hasOutputFields = true
+			g.outputFields = fields
+		}
+	}
+
+	return g, nil
+}
+
+// grantIdType returns the resource type of the given grant ID, or an empty
+// string if it is unknown. Templated IDs resolve to the type they stand for.
+func grantIdType(id string) string {
+	if strings.HasPrefix(id, "{{") {
+		tmpl := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(id, "{{"), "}}"))
+		switch {
+		case !slices.Contains(grantTemplates, tmpl):
+			return ""
+		case tmpl == "user.id", tmpl == ".User.Id":
+			return "user"
+		default:
+			return "account"
+		}
+	}
+	t := globals.ResourceInfoFromPrefix(id).Type.String()
+	if _, ok := grantResourceTypes[t]; !ok {
+		return ""
+	}
+	return t
+}
+
+func grantActionKnown(a string) bool {
+	for _, rt := range grantResourceTypes {
+		if slices.ContainsFunc(rt.actions, func(valid string) bool { return grantActionIsOrParent(a, valid) }) {
+			return true
+		}
+	}
+	return false
+}
+
+// grantActionIsOrParent reports whether action is either the same as suspect
+// or its parent, e.g. "read" is the parent of "read:self".
+func grantActionIsOrParent(action, suspect string) bool {
+	return action == suspect || strings.HasPrefix(suspect, action+":")
+}
+
+func grantTypeParent(typ string) string {
+	if p := grantResourceTypes[typ].parent; p != "" {
+		return p
+	}
+	return typ
+}
+
+func grantTypeHasChildren(typ string) bool {
+	for _, rt := range grantResourceTypes {
+		if rt.parent == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// validateGrant parses a grant string. Grants that are only rejected by the
+// checks against grantResourceTypes are reported as a warning instead of an
+// error when grantValidationEnv is set to "warn".
+func validateGrant(grant string) (warning string, err error) {
+	if _, err := parseGrant(grant); err != nil {
+		var grantErr *grantError
+		if errors.As(err, &grantErr) && grantErr.unsupported && os.Getenv(grantValidationEnv) == grantValidationWarn {
+			return err.Error(), nil
+		}
+		return "", err
+	}
+	return "", nil
+}
+
+// validateGrantString is a schema.SchemaValidateFunc that rejects malformed
+// grant strings at plan time.
+func validateGrantString(i interface{}, k string) (warnings []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	warning, err := validateGrant(v)
+	if err != nil {
+		return nil, []error{err}
+	}
+	if warning != "" {
+		return []string{warning}, nil
+	}
+	return nil, nil
+}
+
+// canonicalGrantString renders the components of a grant to the canonical
+// grant string format. Components are sorted so that equivalent grants always
+// render to the same string.
+func canonicalGrantString(ids []string, typ string, actions, outputFields []string) string {
+	var builder []string
+	if len(ids) > 0 {
+		ids = slices.Clone(ids)
+		slices.Sort(ids)
+		builder = append(builder, fmt.Sprintf("ids=%s", strings.Join(ids, ",")))
+	}
+	if typ != "" {
+		builder = append(builder, fmt.Sprintf("type=%s", strings.ToLower(typ)))
+	}
+	if len(actions) > 0 {
+		lowered := make([]string, 0, len(actions))
+		for _, a := range actions {
+			lowered = append(lowered, strings.ToLower(a))
+		}
+		slices.Sort(lowered)
+		builder = append(builder, fmt.Sprintf("actions=%s", strings.Join(lowered, ",")))
+	}
+	if len(outputFields) > 0 {
+		outputFields = slices.Clone(outputFields)
+		slices.Sort(outputFields)
+		builder = append(builder, fmt.Sprintf("output_fields=%s", strings.Join(outputFields, ",")))
+	}
+	return strings.Join(builder, ";")
+}
+
+// grantResourceTypeNames returns the sorted resource types that can be used
+// in the type component of a grant, including the wildcard.
+func grantResourceTypeNames() []string {
+	names := make([]string, 0, len(grantResourceTypes)+1)
+	names = append(names, grantWildcard)
+	for name := range grantResourceTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// modernizeGrant rewrites the deprecated parts of a grant string to their
+// modern equivalent: the "id" field becomes "ids" and deprecated actions are
+// replaced by the action that superseded them. Grants without deprecated
+// parts are returned unchanged.
+func modernizeGrant(grantString string) (string, error) {
+	if len(grantString) == 0 {
+		return "", errors.New("missing grant string")
+	}
+
+	if grantString[0] == '{' {
+		raw := make(map[string]any, 4)
+		if err := json.Unmarshal([]byte(grantString), &raw); err != nil {
+			return "", fmt.Errorf("error json unmarshalling grant string: %w", err)
+		}
+		changed := false
+		if id, ok := raw["id"]; ok {
+			delete(raw, "id")
+			raw["ids"] = []any{id}
+			changed = true
+		}
+		if actions, ok := raw["actions"].([]any); ok {
+			for i, a := range actions {
+				if s, ok := a.(string); ok {
+					if replacement, ok := deprecatedGrantActions[strings.ToLower(s)]; ok {
+						actions[i] = replacement
+						changed = true
+					}
+				}
+			}
+		}
+		if !changed {
+			return grantString, nil
+		}
+		out, err := json.Marshal(raw)
+		if err != nil {
+			return "", fmt.Errorf("error json marshalling grant string: %w", err)
+		}
+		return string(out), nil
+	}
+
+	segments := strings.Split(grantString, ";")
+	for i, segment := range segments {
+		key, value, ok := strings.Cut(segment, "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "id":
+			segments[i] = "ids=" + value
+		case "actions":
+			actions := strings.Split(value, ",")
+			for j, a := range actions {
+				if replacement, ok := deprecatedGrantActions[strings.ToLower(a)]; ok {
+					actions[j] = replacement
+				}
+			}
+			segments[i] = key + "=" + strings.Join(actions, ",")
+		}
+	}
+	return strings.Join(segments, ";"), nil
+}
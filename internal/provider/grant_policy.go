@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	grantPolicyKey                      = "grant_policy"
+	grantPolicyWildcardTypesKey         = "wildcard_types"
+	grantPolicyWildcardActionsKey       = "wildcard_actions"
+	grantPolicyDangerousActionsKey      = "dangerous_actions"
+	grantPolicyDangerousActionsLevelKey = "dangerous_actions_level"
+	grantPolicyGlobalDescendantsKey     = "global_descendants"
+
+	grantPolicyWarn = "warn"
+	grantPolicyDeny = "deny"
+
+	grantScopeDescendants = "descendants"
+)
+
+func grantPolicySchema() *schema.Schema {
+	levelSchema := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Description:  description + " One of `warn` or `deny`. Not checked if unset.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{grantPolicyWarn, grantPolicyDeny}, false),
+		}
+	}
+
+	return &schema.Schema{
+		Description: "A policy applied to the grants of `boundary_role`, `boundary_role_grant` and `boundary_role_grant_scope` resources to catch overly broad permissions. " +
+			"Violations of rules set to `deny` fail the plan. Violations of rules set to `warn` are only reported " +
+			"as warnings when the role is created or updated by `terraform apply`, as the plan cannot report warnings.",
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				grantPolicyWildcardTypesKey:   levelSchema("How to treat grants with a wildcard type, e.g. `type=*`."),
+				grantPolicyWildcardActionsKey: levelSchema("How to treat grants with wildcard actions, e.g. `actions=*`."),
+				grantPolicyDangerousActionsKey: {
+					Description: "Actions that should not be granted, e.g. `set-password` or `delete`. A grant with " +
+						"wildcard actions grants all of them.",
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				grantPolicyDangerousActionsLevelKey: {
+					Description:  "How to treat grants that include one of `dangerous_actions`. One of `warn` or `deny`. Defaults to `deny`.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      grantPolicyDeny,
+					ValidateFunc: validation.StringInSlice([]string{grantPolicyWarn, grantPolicyDeny}, false),
+				},
+				grantPolicyGlobalDescendantsKey: levelSchema("How to treat roles in the global scope whose `grant_scope_ids` include `descendants`."),
+			},
+		},
+	}
+}
+
+// grantPolicy is the provider-level policy used to lint role grants.
+type grantPolicy struct {
+	wildcardTypes         string
+	wildcardActions       string
+	dangerousActions      []string
+	dangerousActionsLevel string
+	globalDescendants     string
+}
+
+// grantPolicyFromConfig returns the grant policy configured on the provider,
+// or nil if there is none.
+func grantPolicyFromConfig(d *schema.ResourceData) *grantPolicy {
+	raw, ok := d.GetOk(grantPolicyKey)
+	if !ok {
+		return nil
+	}
+	list := raw.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]interface{})
+	return &grantPolicy{
+		wildcardTypes:         m[grantPolicyWildcardTypesKey].(string),
+		wildcardActions:       m[grantPolicyWildcardActionsKey].(string),
+		dangerousActions:      setToStrings(m[grantPolicyDangerousActionsKey]),
+		dangerousActionsLevel: m[grantPolicyDangerousActionsLevelKey].(string),
+		globalDescendants:     m[grantPolicyGlobalDescendantsKey].(string),
+	}
+}
+
+// check evaluates the grants and grant scopes of a role against the policy and
+// returns a diagnostic for each violation. It is safe to call on a nil policy.
+func (p *grantPolicy) check(roleScopeId string, grants, grantScopeIds []string) diag.Diagnostics {
+	if p == nil {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	report := func(level, summary, detail string) {
+		switch level {
+		case grantPolicyWarn:
+			diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: summary, Detail: detail})
+		case grantPolicyDeny:
+			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: summary, Detail: detail})
+		}
+	}
+
+	for _, grant := range grants {
+		g, err := parseGrant(grant)
+		if err != nil {
+			// malformed grants are reported by validateGrantString
+			continue
+		}
+		if g.typ == grantWildcard {
+			report(p.wildcardTypes, "grant policy: wildcard type", fmt.Sprintf("Grant %q applies to all resource types.", grant))
+		}
+		wildcardActions := slices.Contains(g.actions, grantWildcard)
+		if wildcardActions {
+			report(p.wildcardActions, "grant policy: wildcard actions", fmt.Sprintf("Grant %q grants all actions.", grant))
+		}
+		for _, a := range p.dangerousActions {
+			if wildcardActions || slices.Contains(g.actions, a) {
+				report(p.dangerousActionsLevel, "grant policy: dangerous action", fmt.Sprintf("Grant %q grants the %q action.", grant, a))
+			}
+		}
+	}
+
+	if roleScopeId == "global" && slices.Contains(grantScopeIds, grantScopeDescendants) {
+		report(p.globalDescendants, "grant policy: descendants on global role",
+			"Role in the global scope applies its grants to all descendant scopes.")
+	}
+
+	return diags
+}
+
+// checkRoleAttachment checks a grant or grant scope added to an existing role
+// by the boundary_role_grant and boundary_role_grant_scope resources. The role
+// is only read when its scope matters, that is when grant scopes are checked.
+// A role that is not found, e.g. one deleted outside of Terraform during plan,
+// is not checked and adding to it fails on apply.
+func (p *grantPolicy) checkRoleAttachment(ctx context.Context, client *api.Client, roleId string, grants, grantScopeIds []string) diag.Diagnostics {
+	if p == nil {
+		return nil
+	}
+	var roleScopeId string
+	if p.globalDescendants != "" && len(grantScopeIds) > 0 {
+		rr, err := roles.NewClient(client).Read(ctx, roleId)
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		if err != nil {
+			return diag.Errorf("error reading role %q: %v", roleId, err)
+		}
+		roleScopeId = rr.GetItem().ScopeId
+	}
+	return p.check(roleScopeId, grants, grantScopeIds)
+}
+
+// grantPolicyError returns the first denied violation of diags as an error, so
+// that CustomizeDiff can fail the plan. Warnings are emitted on apply instead.
+func grantPolicyError(diags diag.Diagnostics) error {
+	for _, diagnostic := range diags {
+		if diagnostic.Severity == diag.Error {
+			return fmt.Errorf("%s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+	return nil
+}
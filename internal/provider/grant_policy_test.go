@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	globalRoleWithDescendants = `
+resource "boundary_role" "descendants" {
+	name            = "descendants"
+	scope_id        = "global"
+	grant_scope_ids = ["descendants"]
+	grant_strings   = ["ids=*;type=scope;actions=read"]
+}`
+
+	grantPolicyDenyDescendants = `
+		wildcard_types     = "warn"
+		global_descendants = "deny"`
+
+	grantPolicyDenyAll = `
+		wildcard_types     = "deny"
+		global_descendants = "deny"`
+
+	globalRoleForAttachments = `
+resource "boundary_role" "attachments" {
+	name     = "attachments"
+	scope_id = "global"
+	lifecycle {
+		ignore_changes = [grant_strings, grant_scope_ids]
+	}
+}`
+
+	globalRoleGrantWildcard = `
+resource "boundary_role_grant" "wildcard" {
+	role_id      = boundary_role.attachments.id
+	grant_string = "ids=*;type=*;actions=*"
+}`
+
+	globalRoleGrantScopeDescendants = `
+resource "boundary_role_grant_scope" "descendants" {
+	role_id        = boundary_role.attachments.id
+	grant_scope_id = "descendants"
+}`
+)
+
+func TestGrantPolicyCheck(t *testing.T) {
+	policy := &grantPolicy{
+		wildcardTypes:         grantPolicyDeny,
+		wildcardActions:       grantPolicyWarn,
+		dangerousActions:      []string{"set-password"},
+		dangerousActionsLevel: grantPolicyWarn,
+		globalDescendants:     grantPolicyDeny,
+	}
+
+	severities := func(diags diag.Diagnostics) []diag.Severity {
+		var out []diag.Severity
+		for _, d := range diags {
+			out = append(out, d.Severity)
+		}
+		return out
+	}
+
+	assert.Empty(t, policy.check("o_1234567890", []string{"ids=*;type=target;actions=read"}, []string{"this"}))
+	assert.Equal(t, []diag.Severity{diag.Error}, severities(policy.check("o_1234567890", []string{"ids=*;type=*;actions=read"}, nil)))
+	assert.Equal(t, []diag.Severity{diag.Warning}, severities(policy.check("o_1234567890", []string{"ids=*;type=account;actions=set-password"}, nil)))
+	// wildcard actions also grant the dangerous actions
+	assert.Equal(t, []diag.Severity{diag.Warning, diag.Warning}, severities(policy.check("o_1234567890", []string{"ids=*;type=account;actions=*"}, nil)))
+	assert.Equal(t, []diag.Severity{diag.Error}, severities(policy.check("global", nil, []string{"this", "descendants"})))
+	assert.Empty(t, policy.check("o_1234567890", nil, []string{"descendants"}))
+
+	var nilPolicy *grantPolicy
+	assert.Empty(t, nilPolicy.check("global", []string{"ids=*;type=*;actions=*"}, []string{"descendants"}))
+}
+
+func TestAccGrantPolicy(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// denied grants fail the plan
+				Config:      testConfigWithGrantPolicy(url, grantPolicyDenyDescendants, globalRoleWithDescendants),
+				ExpectError: regexp.MustCompile(`grant policy: descendants on global role`),
+			},
+			{
+				// warnings do not prevent the role from being created
+				Config: testConfigWithGrantPolicy(url, grantPolicyDenyDescendants, fooOrg, firstProjectFoo, projRoleWithGrants),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleResourceExists(provider, "boundary_role.with_grants"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGrantPolicyAttachments(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// the role does not exist yet, the grant scope is checked on create
+				Config:      testConfigWithGrantPolicy(url, grantPolicyDenyAll, globalRoleForAttachments, globalRoleGrantScopeDescendants),
+				ExpectError: regexp.MustCompile(`grant policy: descendants on global role`),
+			},
+			{
+				Config: testConfigWithGrantPolicy(url, grantPolicyDenyAll, globalRoleForAttachments),
+				Check:  testAccCheckRoleResourceExists(provider, "boundary_role.attachments"),
+			},
+			{
+				// the scope of the existing role is read to fail the plan
+				Config:             testConfigWithGrantPolicy(url, grantPolicyDenyAll, globalRoleForAttachments, globalRoleGrantScopeDescendants),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ExpectError:        regexp.MustCompile(`grant policy: descendants on global role`),
+			},
+			{
+				Config:      testConfigWithGrantPolicy(url, grantPolicyDenyAll, globalRoleForAttachments, globalRoleGrantWildcard),
+				ExpectError: regexp.MustCompile(`grant policy: wildcard type`),
+			},
+			{
+				// warnings do not prevent the grant from being added
+				Config: testConfigWithGrantPolicy(url, grantPolicyDenyDescendants, globalRoleForAttachments, globalRoleGrantWildcard),
+				Check:  testAccCheckRoleResourceGrantsSet(provider, "boundary_role.attachments", []string{"ids=*;type=*;actions=*"}),
+			},
+		},
+	})
+}
@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGrant(t *testing.T) {
+	tests := []struct {
+		name    string
+		grant   string
+		wantErr string
+	}{
+		{name: "wildcard", grant: "ids=*;type=*;actions=*"},
+		{name: "read", grant: "ids=*;type=*;actions=read"},
+		{name: "specific type", grant: "ids=*;type=target;actions=read,authorize-session"},
+		{name: "collection", grant: "type=target;actions=create,list"},
+		{name: "worker collection subaction", grant: "type=worker;actions=create"},
+		{name: "pinned child type", grant: "ids=hcst_1234567890;type=host-set;actions=create,list,add-hosts"},
+		{name: "self subaction", grant: "ids=*;type=auth-token;actions=read:self,delete:self"},
+		{name: "template", grant: "ids={{user.id}};actions=read,update"},
+		{name: "output fields only", grant: "ids=*;type=*;output_fields=id,name"},
+		{name: "deprecated id", grant: "id=*;type=*;actions=read"},
+		{name: "deprecated action", grant: "ids=*;type=target;actions=add-host-sets"},
+		{name: "json", grant: `{"ids":["*"],"type":"target","actions":["read"]}`},
+		{
+			name:    "missing value",
+			grant:   "ids=*;type=;actions=read",
+			wantErr: `invalid grant "ids=*;type=;actions=read": "type=" component: missing value`,
+		},
+		{
+			name:    "unknown action",
+			grant:   "ids=*;type=*;actions=badaction",
+			wantErr: `"actions" component: unknown action "badaction"`,
+		},
+		{
+			name:    "action invalid for type",
+			grant:   "ids=*;type=host-set;actions=authorize-session",
+			wantErr: `"actions" component: action "authorize-session" is not valid for type host-set`,
+		},
+		{
+			name:    "action invalid for id type",
+			grant:   "ids=ttcp_1234567890;actions=add-hosts",
+			wantErr: `"actions" component: action "add-hosts" is not valid for type target`,
+		},
+		{
+			name:    "unknown type",
+			grant:   "ids=*;type=bogus;actions=read",
+			wantErr: `"type" component: unknown type specifier "bogus"`,
+		},
+		{
+			name:    "wildcard id without type",
+			grant:   "ids=*;actions=read",
+			wantErr: `"type" component: a wildcard id requires a type`,
+		},
+		{
+			name:    "type not a child of id type",
+			grant:   "ids=hcst_1234567890;type=target;actions=read",
+			wantErr: `"type" component: target is not a child type of the type (host-catalog) of the specified id`,
+		},
+		{
+			name:    "wildcard type on id without children",
+			grant:   "ids=ttcp_1234567890;type=*;actions=read",
+			wantErr: `"type" component: wildcard type is not allowed for ids of type target`,
+		},
+		{
+			name:    "mixed id types",
+			grant:   "ids=ttcp_1234567890,u_1234567890;actions=read",
+			wantErr: `"ids" component: contains ids of differently-typed resources`,
+		},
+		{
+			name:    "unknown id prefix",
+			grant:   "ids=foo_1234567890;actions=read",
+			wantErr: `"ids" component: id "foo_1234567890" is of an unknown resource type`,
+		},
+		{
+			name:    "unknown template",
+			grant:   "ids={{group.id}};actions=read",
+			wantErr: `"ids" component: unknown template "{{group.id}}"`,
+		},
+		{
+			name:    "collection with id action",
+			grant:   "type=target;actions=read",
+			wantErr: `"actions" component: action "read" is not allowed without an id`,
+		},
+		{
+			name:    "wildcard action with others",
+			grant:   "ids=*;type=*;actions=*,read",
+			wantErr: `"actions" component: "*" cannot be specified with other actions`,
+		},
+		{
+			name:    "id and ids",
+			grant:   `{"id":"*","ids":["*"],"type":"*","actions":["read"]}`,
+			wantErr: `both "id" and "ids" are set`,
+		},
+		{
+			name:    "json bad actions",
+			grant:   `{"ids":["*"],"type":"*","actions":"read"}`,
+			wantErr: `"actions" component: unable to interpret as array of strings`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseGrant(tt.grant)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestCanonicalGrantString(t *testing.T) {
+	assert.Equal(t,
+		"ids=*;type=target;actions=authorize-session,read;output_fields=id,name",
+		canonicalGrantString([]string{"*"}, "target", []string{"read", "Authorize-Session"}, []string{"name", "id"}))
+	assert.Equal(t,
+		canonicalGrantString([]string{"u_2", "u_1"}, "", []string{"update", "read"}, nil),
+		canonicalGrantString([]string{"u_1", "u_2"}, "", []string{"read", "update"}, nil))
+	assert.Equal(t, "type=target;actions=create,list", canonicalGrantString(nil, "target", []string{"list", "create"}, nil))
+}
+
+func TestModernizeGrant(t *testing.T) {
+	tests := []struct {
+		grant string
+		want  string
+	}{
+		{grant: "ids=*;type=*;actions=read", want: "ids=*;type=*;actions=read"},
+		{grant: "id=*;type=*;actions=read", want: "ids=*;type=*;actions=read"},
+		{grant: "id=ttcp_1234567890;actions=read,add-host-sets", want: "ids=ttcp_1234567890;actions=read,add-host-sources"},
+		{grant: `{"ids":["*"],"type":"*","actions":["read"]}`, want: `{"ids":["*"],"type":"*","actions":["read"]}`},
+		{grant: `{"id":"*","type":"target","actions":["read","remove-credential-libraries"]}`, want: `{"actions":["read","remove-credential-sources"],"ids":["*"],"type":"target"}`},
+	}
+	for _, tt := range tests {
+		got, err := modernizeGrant(tt.grant)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+}
+
+func TestValidateGrantUnsupported(t *testing.T) {
+	const grant = "ids=*;type=target;actions=connect"
+
+	_, errs := validateGrantString(grant, "grant_strings")
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), `unknown action "connect"`)
+
+	t.Setenv(grantValidationEnv, grantValidationWarn)
+	warnings, errs := validateGrantString(grant, "grant_strings")
+	assert.Empty(t, errs)
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], `unknown action "connect"`)
+
+	// malformed grants are still rejected
+	_, errs = validateGrantString("ids=*;type=;actions=read", "grant_strings")
+	assert.Len(t, errs, 1)
+}
+
+// TestGrantResourceTypesMatchBoundary checks that grantResourceTypes lists
+// the action sets registered by the controller handlers of the boundary
+// module in go.mod. The handlers are internal to the module, so their
+// sources are parsed.
+func TestGrantResourceTypesMatchBoundary(t *testing.T) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "github.com/hashicorp/boundary").Output()
+	if err != nil || len(strings.TrimSpace(string(out))) == 0 {
+		t.Skipf("boundary module sources not found: %v", err)
+	}
+	dir := filepath.Join(strings.TrimSpace(string(out)), "internal")
+
+	actionNames := boundaryTypeStrings(t, filepath.Join(dir, "types", "action", "action.go"))
+	resourceNames := boundaryTypeStrings(t, filepath.Join(dir, "types", "resource", "resource.go"))
+
+	handlers, err := filepath.Glob(filepath.Join(dir, "daemon", "controller", "handlers", "*"))
+	require.NoError(t, err)
+	registered := map[string][]string{}
+	for _, handler := range handlers {
+		typ, actions := boundaryRegisteredActions(t, handler)
+		if typ == "" {
+			continue
+		}
+		name, ok := resourceNames[typ]
+		require.True(t, ok, "unknown resource type %s", typ)
+		for _, a := range actions {
+			actionName, ok := actionNames[a]
+			require.True(t, ok, "unknown action %s", a)
+			registered[name] = append(registered[name], actionName)
+		}
+	}
+	require.NotEmpty(t, registered)
+
+	for name, actions := range registered {
+		rt, ok := grantResourceTypes[name]
+		if !assert.True(t, ok, "type %s is missing from grantResourceTypes", name) {
+			continue
+		}
+		slices.Sort(actions)
+		assert.ElementsMatch(t, slices.Compact(actions), rt.actions, "actions of type %s", name)
+	}
+	for name := range grantResourceTypes {
+		assert.Contains(t, registered, name, "type %s is not registered by Boundary", name)
+	}
+}
+
+// boundaryTypeStrings maps the constants of the Type declared in a file of
+// the boundary module to their String value, which indexes an array of the
+// strings.
+func boundaryTypeStrings(t *testing.T, path string) map[string]string {
+	t.Helper()
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	require.NoError(t, err)
+
+	values := map[string]int{}
+	var strs []string
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			if decl.Tok != token.CONST {
+				continue
+			}
+			for i, spec := range decl.Specs {
+				vs := spec.(*ast.ValueSpec)
+				value := i
+				if len(vs.Values) == 1 {
+					if lit, ok := vs.Values[0].(*ast.BasicLit); ok && lit.Kind == token.INT {
+						value, err = strconv.Atoi(lit.Value)
+						require.NoError(t, err)
+					}
+				}
+				for _, name := range vs.Names {
+					values[name.Name] = value
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Name.Name != "String" || decl.Recv == nil {
+				continue
+			}
+			ast.Inspect(decl.Body, func(n ast.Node) bool {
+				if lit, ok := n.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					s, err := strconv.Unquote(lit.Value)
+					require.NoError(t, err)
+					strs = append(strs, s)
+				}
+				return true
+			})
+		}
+	}
+
+	names := map[string]string{}
+	for name, value := range values {
+		if value < len(strs) {
+			names[name] = strs[value]
+		}
+	}
+	return names
+}
+
+// boundaryRegisteredActions returns the resource type a handler package of
+// the boundary module registers with action.RegisterResource, and the
+// actions of the package variables it registers.
+func boundaryRegisteredActions(t *testing.T, dir string) (typ string, actions []string) {
+	t.Helper()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	require.NoError(t, err)
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		require.NoError(t, err)
+		files = append(files, f)
+	}
+
+	// selectorsOf returns the names selected from pkg in n
+	selectorsOf := func(n ast.Node, pkg string) []string {
+		var names []string
+		ast.Inspect(n, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if x, ok := sel.X.(*ast.Ident); ok && x.Name == pkg {
+					names = append(names, sel.Sel.Name)
+				}
+			}
+			return true
+		})
+		return names
+	}
+
+	var vars []string
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) != 3 || !slices.Equal(selectorsOf(call.Fun, "action"), []string{"RegisterResource"}) {
+				return true
+			}
+			typ = selectorsOf(call.Args[0], "resource")[0]
+			for _, arg := range call.Args[1:] {
+				ast.Inspect(arg, func(n ast.Node) bool {
+					if id, ok := n.(*ast.Ident); ok {
+						vars = append(vars, id.Name)
+					}
+					return true
+				})
+			}
+			return false
+		})
+	}
+	if typ == "" {
+		return "", nil
+	}
+
+	// The action sets are declared with the variables, or assigned to them
+	// or to their elements in init functions.
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.ValueSpec:
+				for i, name := range n.Names {
+					if slices.Contains(vars, name.Name) && i < len(n.Values) {
+						actions = append(actions, selectorsOf(n.Values[i], "action")...)
+					}
+				}
+			case *ast.AssignStmt:
+				for i, lhs := range n.Lhs {
+					if index, ok := lhs.(*ast.IndexExpr); ok {
+						lhs = index.X
+					}
+					if id, ok := lhs.(*ast.Ident); ok && slices.Contains(vars, id.Name) && i < len(n.Rhs) {
+						actions = append(actions, selectorsOf(n.Rhs[i], "action")...)
+					}
+				}
+			}
+			return true
+		})
+	}
+	// Drop the functions and types of the action package
+	actions = slices.DeleteFunc(actions, func(a string) bool {
+		return slices.Contains([]string{"NewActionSet", "ActionSet", "Union", "Type"}, a)
+	})
+	return typ, actions
+}
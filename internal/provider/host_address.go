@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+)
+
+// hostnameLabelRe matches a label of a hostname as defined by RFC 1123.
+var hostnameLabelRe = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// allDigitsRe matches labels only made of digits, which cannot end a hostname
+// and most likely are a mistyped IPv4 address.
+var allDigitsRe = regexp.MustCompile(`^[0-9]+$`)
+
+// checkHostAddress checks that an address is an IP address or a hostname, as
+// Boundary expects for the address of a static host. Ports are set on targets
+// so they are rejected.
+func checkHostAddress(address string) error {
+	if address == "" {
+		return fmt.Errorf("the address is empty")
+	}
+	if net.ParseIP(address) != nil {
+		return nil
+	}
+	if host, port, err := net.SplitHostPort(address); err == nil && port != "" {
+		return fmt.Errorf("%q has the port %s, ports are set on targets instead, use %q", address, port, host)
+	}
+	if strings.Contains(address, ":") {
+		return fmt.Errorf("%q is not a valid IP address", address)
+	}
+
+	name := strings.TrimSuffix(address, ".")
+	if len(name) > 253 {
+		return fmt.Errorf("%q is not a valid hostname, it is longer than 253 characters", address)
+	}
+	labels := strings.Split(name, ".")
+	for _, label := range labels {
+		if !hostnameLabelRe.MatchString(label) {
+			return fmt.Errorf("%q is not a valid IP address or hostname", address)
+		}
+	}
+	if allDigitsRe.MatchString(labels[len(labels)-1]) {
+		return fmt.Errorf("%q is not a valid IP address or hostname", address)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckHostAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		err     string
+	}{
+		{address: "10.0.0.1"},
+		{address: "::1"},
+		{address: "2001:db8::1"},
+		{address: "web-1"},
+		{address: "web-1.example.com"},
+		{address: "web-1.example.com."},
+		{address: "", err: "the address is empty"},
+		{address: "10.0.0.256", err: `"10.0.0.256" is not a valid IP address or hostname`},
+		{address: "10.0.0.1:22", err: `"10.0.0.1:22" has the port 22, ports are set on targets instead, use "10.0.0.1"`},
+		{address: "[::1]:22", err: `"[::1]:22" has the port 22, ports are set on targets instead, use "::1"`},
+		{address: "2001:db8::zz", err: `"2001:db8::zz" is not a valid IP address`},
+		{address: "web_1.example.com", err: `"web_1.example.com" is not a valid IP address or hostname`},
+		{address: "-web.example.com", err: `"-web.example.com" is not a valid IP address or hostname`},
+		{address: "web..example.com", err: `"web..example.com" is not a valid IP address or hostname`},
+		{address: "web 1", err: `"web 1" is not a valid IP address or hostname`},
+		{address: strings.Repeat("a.", 127) + "com", err: "it is longer than 253 characters"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			err := checkHostAddress(tt.address)
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hostcatalogs"
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// nameNotFoundError is returned when looking up a resource by a name that no
+// resource has. When planning, the resource may still be created by the same
+// apply.
+type nameNotFoundError struct {
+	kind   string
+	name   string
+	parent string
+}
+
+func (e *nameNotFoundError) Error() string {
+	return fmt.Sprintf("no %s named %q in %s", e.kind, e.name, e.parent)
+}
+
+// hostCatalogIdByName returns the ID of the host catalog with the given name
+// in a scope.
+func hostCatalogIdByName(ctx context.Context, client *api.Client, scopeId, name string) (string, error) {
+	hcl, err := hostcatalogs.NewClient(client).List(ctx, scopeId, hostcatalogs.WithFilter(filterWithItemNameEquals(name)))
+	if err != nil {
+		return "", fmt.Errorf("error listing host catalogs of scope %q: %w", scopeId, err)
+	}
+	for _, hc := range hcl.GetItems() {
+		if hc.Name == name {
+			return hc.Id, nil
+		}
+	}
+	return "", &nameNotFoundError{kind: "host catalog", name: name, parent: fmt.Sprintf("scope %q", scopeId)}
+}
+
+// hostSetIdByName returns the ID of the host set with the given name in a
+// host catalog.
+func hostSetIdByName(ctx context.Context, client *api.Client, hostCatalogId, name string) (string, error) {
+	hsl, err := hostsets.NewClient(client).List(ctx, hostCatalogId, hostsets.WithFilter(filterWithItemNameEquals(name)))
+	if err != nil {
+		return "", fmt.Errorf("error listing host sets of host catalog %q: %w", hostCatalogId, err)
+	}
+	for _, hs := range hsl.GetItems() {
+		if hs.Name == name {
+			return hs.Id, nil
+		}
+	}
+	return "", &nameNotFoundError{kind: "host set", name: name, parent: fmt.Sprintf("host catalog %q", hostCatalogId)}
+}
+
+// hostIdsByName returns the IDs of the hosts with the given names in a host
+// catalog, sorted.
+func hostIdsByName(ctx context.Context, client *api.Client, hostCatalogId string, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	hl, err := hosts.NewClient(client).List(ctx, hostCatalogId)
+	if err != nil {
+		return nil, fmt.Errorf("error listing hosts of host catalog %q: %w", hostCatalogId, err)
+	}
+	byName := make(map[string]string, len(hl.GetItems()))
+	for _, h := range hl.GetItems() {
+		if h.Name != "" {
+			byName[h.Name] = h.Id
+		}
+	}
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		id, ok := byName[name]
+		if !ok {
+			return nil, &nameNotFoundError{kind: "host", name: name, parent: fmt.Sprintf("host catalog %q", hostCatalogId)}
+		}
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids), nil
+}
+
+// rawConfigStrings returns the strings of a list or set attribute in the raw
+// configuration, and whether they are all known. Attributes using computed
+// values to hold resolved IDs have to read what was configured this way, as
+// their planned value also has the resolved IDs.
+func rawConfigStrings(config cty.Value, key string) ([]string, bool) {
+	if config.Type() == cty.NilType || config.IsNull() || !config.IsKnown() {
+		return nil, true
+	}
+	v := config.GetAttr(key)
+	if !v.IsWhollyKnown() {
+		return nil, false
+	}
+	if v.IsNull() {
+		return nil, true
+	}
+	var s []string
+	for it := v.ElementIterator(); it.Next(); {
+		_, e := it.Element()
+		if !e.IsNull() {
+			s = append(s, e.AsString())
+		}
+	}
+	return s, true
+}
+
+// mergeIds returns the sorted union of lists of IDs.
+func mergeIds(lists ...[]string) []string {
+	ids := []string{}
+	for _, l := range lists {
+		ids = append(ids, l...)
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
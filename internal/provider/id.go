@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// pluginPrefix is the prefix of the IDs of host and storage plugins.
+const pluginPrefix = "pl"
+
+// idKind is a kind of Boundary resource that an ID attribute can refer to,
+// along with the ID prefixes Boundary uses for it. Previous prefixes are
+// included since resources created with older versions keep their IDs.
+type idKind struct {
+	name     string
+	prefixes []string
+}
+
+var (
+	globalScopeIds = idKind{"the global scope", []string{globals.GlobalPrefix}}
+	orgIds         = idKind{"an org", []string{globals.OrgPrefix}}
+	projectIds     = idKind{"a project", []string{globals.ProjectPrefix}}
+
+	userIds         = idKind{"a user", []string{globals.UserPrefix}}
+	groupIds        = idKind{"a group", []string{globals.GroupPrefix}}
+	roleIds         = idKind{"a role", []string{globals.RolePrefix}}
+	managedGroupIds = idKind{"a managed group", []string{globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix}}
+
+	passwordAuthMethodIds = idKind{"a password auth method", []string{globals.PasswordAuthMethodPrefix}}
+	oidcAuthMethodIds     = idKind{"an OIDC auth method", []string{globals.OidcAuthMethodPrefix}}
+	ldapAuthMethodIds     = idKind{"an LDAP auth method", []string{globals.LdapAuthMethodPrefix}}
+	accountIds            = idKind{"an account", []string{
+		globals.PasswordAccountPrefix, globals.PasswordAccountPreviousPrefix,
+		globals.OidcAccountPrefix, globals.LdapAccountPrefix,
+	}}
+
+	staticHostCatalogIds = idKind{"a static host catalog", []string{globals.StaticHostCatalogPrefix}}
+	pluginHostCatalogIds = idKind{"a plugin host catalog", []string{globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix}}
+	staticHostSetIds     = idKind{"a static host set", []string{globals.StaticHostSetPrefix}}
+	pluginHostSetIds     = idKind{"a plugin host set", []string{globals.PluginHostSetPrefix, globals.PluginHostSetPreviousPrefix}}
+	staticHostIds        = idKind{"a static host", []string{globals.StaticHostPrefix}}
+	pluginHostIds        = idKind{"a plugin host", []string{globals.PluginHostPrefix, globals.PluginHostPreviousPrefix}}
+
+	staticCredentialStoreIds = idKind{"a static credential store", []string{globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix}}
+	vaultCredentialStoreIds  = idKind{"a Vault credential store", []string{globals.VaultCredentialStorePrefix}}
+	credentialLibraryIds     = idKind{"a credential library", []string{
+		globals.VaultCredentialLibraryPrefix, globals.VaultSshCertificateCredentialLibraryPrefix,
+	}}
+	credentialIds = idKind{"a credential", []string{
+		globals.UsernamePasswordCredentialPrefix, globals.UsernamePasswordCredentialPreviousPrefix,
+		globals.SshPrivateKeyCredentialPrefix, globals.JsonCredentialPrefix,
+	}}
+
+	targetIds        = idKind{"a target", []string{globals.TcpTargetPrefix, globals.SshTargetPrefix}}
+	storageBucketIds = idKind{"a storage bucket", []string{globals.PluginStorageBucketPrefix}}
+	storagePolicyIds = idKind{"a storage policy", []string{globals.StoragePolicyPrefix}}
+	pluginIds        = idKind{"a plugin", []string{pluginPrefix}}
+
+	// idKinds is used to name the kind of a mismatched ID in errors.
+	idKinds = []idKind{
+		globalScopeIds, orgIds, projectIds,
+		userIds, groupIds, roleIds, managedGroupIds,
+		passwordAuthMethodIds, oidcAuthMethodIds, ldapAuthMethodIds, accountIds,
+		staticHostCatalogIds, pluginHostCatalogIds, staticHostSetIds, pluginHostSetIds, staticHostIds, pluginHostIds,
+		staticCredentialStoreIds, vaultCredentialStoreIds, credentialLibraryIds, credentialIds,
+		targetIds, storageBucketIds, storagePolicyIds, pluginIds,
+	}
+)
+
+// Common combinations of the kinds above.
+var (
+	anyScopeIds         = []idKind{globalScopeIds, orgIds, projectIds}
+	orgScopeIds         = []idKind{globalScopeIds, orgIds}
+	authMethodIds       = []idKind{passwordAuthMethodIds, oidcAuthMethodIds, ldapAuthMethodIds}
+	principalIds        = []idKind{userIds, groupIds, managedGroupIds}
+	hostCatalogIds      = []idKind{staticHostCatalogIds, pluginHostCatalogIds}
+	hostSetIds          = []idKind{staticHostSetIds, pluginHostSetIds}
+	hostIds             = []idKind{staticHostIds, pluginHostIds}
+	credentialSourceIds = []idKind{credentialLibraryIds, credentialIds}
+)
+
+// grantScopeKeywords are the special values grant scope IDs can take.
+var grantScopeKeywords = []string{"this", "children", grantScopeDescendants}
+
+// validateGrantScopeId validates a scope ID a role's grants apply to.
+var validateGrantScopeId = validateIdOrKeyword(grantScopeKeywords, anyScopeIds...)
+
+// matches reports whether id has one of the prefixes of k. The global scope
+// is the only ID that is just a prefix.
+func (k idKind) matches(id string) bool {
+	for _, p := range k.prefixes {
+		if id == globals.GlobalPrefix && p == globals.GlobalPrefix {
+			return true
+		}
+		if rest, ok := strings.CutPrefix(id, p+"_"); ok && rest != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// validateId returns a ValidateDiagFunc that checks that an ID refers to one
+// of the given kinds of resources, so that passing e.g. a host catalog where a
+// host set is expected fails at plan time instead of during apply. Empty
+// values are left to the Required or Optional rules of the attribute.
+func validateId(kinds ...idKind) schema.SchemaValidateDiagFunc {
+	return validateIdOrKeyword(nil, kinds...)
+}
+
+// validateIdOrKeyword is like validateId, but also accepts the given keywords
+// for attributes that take special values.
+func validateIdOrKeyword(keywords []string, kinds ...idKind) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		id, ok := i.(string)
+		if !ok || id == "" {
+			return nil
+		}
+		for _, kw := range keywords {
+			if id == kw {
+				return nil
+			}
+		}
+		for _, k := range kinds {
+			if k.matches(id) {
+				return nil
+			}
+		}
+
+		var names, exact, prefixes []string
+		for _, k := range kinds {
+			names = append(names, k.name)
+			for _, p := range k.prefixes {
+				if p == globals.GlobalPrefix {
+					exact = append(exact, fmt.Sprintf("%q", p))
+				} else {
+					prefixes = append(prefixes, p+"_")
+				}
+			}
+		}
+		for _, kw := range keywords {
+			exact = append(exact, fmt.Sprintf("%q", kw))
+		}
+		if len(prefixes) > 0 {
+			exact = append(exact, "an ID starting with "+joinOr(prefixes))
+		}
+
+		got := fmt.Sprintf("%q is not a Boundary ID", id)
+		for _, k := range idKinds {
+			if k.matches(id) {
+				got = fmt.Sprintf("%q is the ID of %s", id, k.name)
+				break
+			}
+		}
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("expected the ID of %s", joinOr(names)),
+			Detail:        fmt.Sprintf("%s. Expected %s.", got, joinOr(exact)),
+			AttributePath: path,
+		}}
+	}
+}
+
+// joinOr joins the elements of s into a list of alternatives.
+func joinOr(s []string) string {
+	switch len(s) {
+	case 0:
+		return ""
+	case 1:
+		return s[0]
+	}
+	return strings.Join(s[:len(s)-1], ", ") + " or " + s[len(s)-1]
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateId(t *testing.T) {
+	path := cty.GetAttrPath("id")
+
+	ok := func(id string, kinds ...idKind) {
+		t.Helper()
+		assert.Empty(t, validateId(kinds...)(id, path), id)
+	}
+	ok("", hostSetIds...)
+	ok("hsst_1234567890", hostSetIds...)
+	ok("hs_1234567890", hostSetIds...)
+	ok("global", orgScopeIds...)
+	ok("o_1234567890", orgScopeIds...)
+	ok("u_auth", principalIds...)
+	ok("mgldap_1234567890", principalIds...)
+	ok("csst_1234567890", staticCredentialStoreIds)
+	ok("cs_1234567890", staticCredentialStoreIds)
+
+	diags := validateId(hostSetIds...)("hcst_1234567890", path)
+	require.Len(t, diags, 1)
+	assert.Equal(t, "expected the ID of a static host set or a plugin host set", diags[0].Summary)
+	assert.Equal(t, `"hcst_1234567890" is the ID of a static host catalog. Expected an ID starting with hsst_, hsplg_ or hs_.`, diags[0].Detail)
+	assert.Equal(t, path, diags[0].AttributePath)
+
+	diags = validateId(orgScopeIds...)("p_1234567890", path)
+	require.Len(t, diags, 1)
+	assert.Equal(t, `"p_1234567890" is the ID of a project. Expected "global" or an ID starting with o_.`, diags[0].Detail)
+
+	diags = validateId(vaultCredentialStoreIds)("vault", path)
+	require.Len(t, diags, 1)
+	assert.Equal(t, `"vault" is not a Boundary ID. Expected an ID starting with csvlt_.`, diags[0].Detail)
+
+	// a bare prefix is not an ID
+	assert.Len(t, validateId(userIds)("u_", path), 1)
+}
+
+func TestValidateGrantScopeId(t *testing.T) {
+	path := cty.GetAttrPath("grant_scope_id")
+
+	for _, id := range []string{"this", "children", "descendants", "global", "o_1234567890", "p_1234567890"} {
+		assert.Empty(t, validateGrantScopeId(id, path), id)
+	}
+	diags := validateGrantScopeId("r_1234567890", path)
+	require.Len(t, diags, 1)
+	assert.Equal(t, `"r_1234567890" is the ID of a role. Expected "global", "this", "children", "descendants" or an ID starting with o_ or p_.`, diags[0].Detail)
+}
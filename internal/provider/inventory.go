@@ -0,0 +1,581 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	inventoryFormatIni  = "ini"
+	inventoryFormatYaml = "yaml"
+	inventoryFormatCsv  = "csv"
+	inventoryFormatJson = "json"
+
+	// ansibleGroupAll and ansibleGroupUngrouped are the groups Ansible puts
+	// every host in implicitly, they are not reported as groups of the hosts.
+	ansibleGroupAll       = "all"
+	ansibleGroupUngrouped = "ungrouped"
+)
+
+var inventoryFormats = []string{inventoryFormatIni, inventoryFormatYaml, inventoryFormatCsv, inventoryFormatJson}
+
+// inventoryHost is a host of an inventory, normalized from any format.
+type inventoryHost struct {
+	Name    string
+	Address string
+	Groups  []string
+}
+
+// inventory collects the hosts and groups of an inventory while it is parsed.
+type inventory struct {
+	addresses map[string]string
+	groups    map[string]map[string]bool
+	// parents are the groups a group is a child of
+	parents map[string]map[string]bool
+}
+
+func newInventory() *inventory {
+	return &inventory{
+		addresses: map[string]string{},
+		groups:    map[string]map[string]bool{},
+		parents:   map[string]map[string]bool{},
+	}
+}
+
+// addHost adds a host to a group. The address is empty when it is not set, in
+// which case the name of the host is its address.
+func (inv *inventory) addHost(name, address, group string) error {
+	if name == "" {
+		return errors.New("a host has no name")
+	}
+	current, ok := inv.addresses[name]
+	switch {
+	case !ok || current == "":
+		inv.addresses[name] = address
+	case address != "" && address != current:
+		return fmt.Errorf("host %q has the addresses %q and %q", name, current, address)
+	}
+	if group != "" {
+		inv.addGroup(group)
+		inv.groups[group][name] = true
+	}
+	return nil
+}
+
+func (inv *inventory) addGroup(group string) {
+	if inv.groups[group] == nil {
+		inv.groups[group] = map[string]bool{}
+	}
+}
+
+// addChild makes a group the child of another, its hosts are also in the
+// parent group.
+func (inv *inventory) addChild(parent, child string) {
+	inv.addGroup(parent)
+	inv.addGroup(child)
+	if inv.parents[child] == nil {
+		inv.parents[child] = map[string]bool{}
+	}
+	inv.parents[child][parent] = true
+}
+
+// ancestors returns a group and all the groups it is a descendant of.
+func (inv *inventory) ancestors(group string, seen map[string]bool) {
+	if seen[group] {
+		return
+	}
+	seen[group] = true
+	for parent := range inv.parents[group] {
+		inv.ancestors(parent, seen)
+	}
+}
+
+// hosts returns the hosts of the inventory sorted by name, with the groups
+// they are in directly or through child groups.
+func (inv *inventory) hosts() []inventoryHost {
+	groups := map[string]map[string]bool{}
+	for group, members := range inv.groups {
+		seen := map[string]bool{}
+		inv.ancestors(group, seen)
+		for name := range members {
+			if groups[name] == nil {
+				groups[name] = map[string]bool{}
+			}
+			maps.Copy(groups[name], seen)
+		}
+	}
+
+	out := make([]inventoryHost, 0, len(inv.addresses))
+	for _, name := range slices.Sorted(maps.Keys(inv.addresses)) {
+		address := inv.addresses[name]
+		if address == "" {
+			address = name
+		}
+		hostGroups := []string{}
+		for _, group := range slices.Sorted(maps.Keys(groups[name])) {
+			if group != ansibleGroupAll && group != ansibleGroupUngrouped {
+				hostGroups = append(hostGroups, group)
+			}
+		}
+		out = append(out, inventoryHost{Name: name, Address: address, Groups: hostGroups})
+	}
+	return out
+}
+
+// inventoryFormat returns the format of an inventory, given explicitly or
+// deduced from the extension of its path or from its contents.
+func inventoryFormat(format, source string, wasPath bool, contents string) (string, error) {
+	if format != "" {
+		return format, nil
+	}
+	if wasPath {
+		name := source
+		if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+			name = name[i+1:]
+		}
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			switch strings.ToLower(name[i+1:]) {
+			case "ini", "cfg":
+				return inventoryFormatIni, nil
+			case "yaml", "yml":
+				return inventoryFormatYaml, nil
+			case "csv":
+				return inventoryFormatCsv, nil
+			case "json":
+				return inventoryFormatJson, nil
+			}
+		} else {
+			// Ansible inventories are commonly files named hosts or inventory
+			return inventoryFormatIni, nil
+		}
+	}
+	if json.Valid([]byte(contents)) {
+		return inventoryFormatJson, nil
+	}
+	return "", fmt.Errorf("cannot deduce the format of the inventory, set it to one of %s", quotedList(inventoryFormats))
+}
+
+// parseInventory parses an inventory in the given format.
+func parseInventory(format, contents string) ([]inventoryHost, error) {
+	inv := newInventory()
+	var err error
+	switch format {
+	case inventoryFormatIni:
+		err = parseAnsibleIni(inv, contents)
+	case inventoryFormatYaml:
+		err = parseAnsibleYaml(inv, contents)
+	case inventoryFormatCsv:
+		err = parseInventoryCsv(inv, contents)
+	case inventoryFormatJson:
+		err = parseInventoryJson(inv, contents)
+	default:
+		err = fmt.Errorf("unsupported inventory format %q, expected %s", format, quotedList(inventoryFormats))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return inv.hosts(), nil
+}
+
+// ansibleSectionRe matches the section headers of INI inventories, such as
+// [web] or [web:children].
+var ansibleSectionRe = regexp.MustCompile(`^\[([^\]:]+)(?::([a-z]+))?\]$`)
+
+// parseAnsibleIni parses an Ansible inventory in the INI format.
+func parseAnsibleIni(inv *inventory, contents string) error {
+	group, kind := ansibleGroupUngrouped, ""
+	scanner := bufio.NewScanner(strings.NewReader(contents))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+		if strings.HasPrefix(text, "[") {
+			m := ansibleSectionRe.FindStringSubmatch(text)
+			if m == nil {
+				return fmt.Errorf("line %d: invalid section %q", line, text)
+			}
+			group, kind = strings.TrimSpace(m[1]), m[2]
+			switch kind {
+			case "", "children", "vars":
+			default:
+				return fmt.Errorf("line %d: invalid section %q, expected %q, %q or no suffix", line, text, ":children", ":vars")
+			}
+			inv.addGroup(group)
+			continue
+		}
+
+		switch kind {
+		case "vars":
+			continue
+		case "children":
+			inv.addChild(group, text)
+			continue
+		}
+
+		fields, err := splitAnsibleHostLine(text)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		var address string
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				return fmt.Errorf("line %d: invalid host variable %q, expected key=value", line, field)
+			}
+			if key == "ansible_host" || (key == "ansible_ssh_host" && address == "") {
+				address = value
+			}
+		}
+		names, err := expandAnsibleHostPattern(stripAnsiblePort(fields[0]))
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		for _, name := range names {
+			if err := inv.addHost(name, address, group); err != nil {
+				return fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// splitAnsibleHostLine splits a host line of an INI inventory into words the
+// way Ansible does with shlex: quotes group words and are removed, a backslash
+// escapes the next character and an unquoted # starts a comment.
+func splitAnsibleHostLine(line string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	escaped := false
+	for _, c := range line {
+		switch {
+		case escaped:
+			// in double quotes only quotes and backslashes are escaped
+			if quote == '"' && c != '"' && c != '\\' {
+				word.WriteRune('\\')
+			}
+			word.WriteRune(c)
+			escaped = false
+		case c == '\\' && quote != '\'':
+			escaped, inWord = true, true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				word.WriteRune(c)
+			}
+		case c == '"' || c == '\'':
+			quote, inWord = c, true
+		case c == '#':
+			return append(words, finishWord(&word, &inWord)...), nil
+		case c == ' ' || c == '\t':
+			words = append(words, finishWord(&word, &inWord)...)
+		default:
+			word.WriteRune(c)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("no closing quotation")
+	}
+	if escaped {
+		return nil, errors.New("no escaped character")
+	}
+	return append(words, finishWord(&word, &inWord)...), nil
+}
+
+// finishWord returns the word being built by splitAnsibleHostLine, if any, and
+// resets it.
+func finishWord(word *strings.Builder, inWord *bool) []string {
+	if !*inWord {
+		return nil
+	}
+	w := word.String()
+	word.Reset()
+	*inWord = false
+	return []string{w}
+}
+
+// ansiblePortRe matches a host with a port, such as web1:2222.
+var ansiblePortRe = regexp.MustCompile(`^([^:]+):[0-9]+$`)
+
+// stripAnsiblePort removes the SSH port of a host of an INI inventory, ports
+// are set on targets instead.
+func stripAnsiblePort(host string) string {
+	if m := ansiblePortRe.FindStringSubmatch(host); m != nil {
+		return m[1]
+	}
+	return host
+}
+
+// ansibleRangeRe matches the first range of a host pattern, such as [01:50],
+// [a:f] or [0:100:10].
+var ansibleRangeRe = regexp.MustCompile(`\[([0-9a-zA-Z]*):([0-9a-zA-Z]+)(?::([0-9]+))?\]`)
+
+// expandAnsibleHostPattern expands the numeric and alphabetic ranges of a
+// host pattern, web[01:03] being web01, web02 and web03.
+func expandAnsibleHostPattern(pattern string) ([]string, error) {
+	loc := ansibleRangeRe.FindStringSubmatchIndex(pattern)
+	if loc == nil {
+		if strings.ContainsAny(pattern, "[]") {
+			return nil, fmt.Errorf("invalid host pattern %q", pattern)
+		}
+		return []string{pattern}, nil
+	}
+	prefix, suffix := pattern[:loc[0]], pattern[loc[1]:]
+	start, end := pattern[loc[2]:loc[3]], pattern[loc[4]:loc[5]]
+	stride := 1
+	if loc[6] >= 0 {
+		stride, _ = strconv.Atoi(pattern[loc[6]:loc[7]])
+		if stride < 1 {
+			return nil, fmt.Errorf("invalid stride in host pattern %q", pattern)
+		}
+	}
+
+	var values []string
+	if start == "" {
+		start = "0"
+	}
+	first, errFirst := strconv.Atoi(start)
+	last, errLast := strconv.Atoi(end)
+	switch {
+	case errFirst == nil && errLast == nil:
+		if first > last {
+			return nil, fmt.Errorf("invalid range in host pattern %q, %s is after %s", pattern, start, end)
+		}
+		// the numbers are zero padded to the width of the start when it is
+		format := "%d"
+		if len(start) > 1 && start[0] == '0' {
+			format = fmt.Sprintf("%%0%dd", len(start))
+		}
+		for i := first; i <= last; i += stride {
+			values = append(values, fmt.Sprintf(format, i))
+		}
+	case len(start) == 1 && len(end) == 1 && isAsciiLetter(start[0]) && isAsciiLetter(end[0]):
+		if start[0] > end[0] {
+			return nil, fmt.Errorf("invalid range in host pattern %q, %s is after %s", pattern, start, end)
+		}
+		for c := int(start[0]); c <= int(end[0]); c += stride {
+			values = append(values, string(rune(c)))
+		}
+	default:
+		return nil, fmt.Errorf("invalid range in host pattern %q", pattern)
+	}
+
+	var out []string
+	for _, v := range values {
+		expanded, err := expandAnsibleHostPattern(prefix + v + suffix)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, expanded...)
+	}
+	return out, nil
+}
+
+func isAsciiLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// ansibleYamlGroup is a group of an Ansible inventory in the YAML format.
+type ansibleYamlGroup struct {
+	Hosts    map[string]map[string]interface{} `yaml:"hosts"`
+	Children map[string]*ansibleYamlGroup      `yaml:"children"`
+	Vars     map[string]interface{}            `yaml:"vars"`
+}
+
+// parseAnsibleYaml parses an Ansible inventory in the YAML format.
+func parseAnsibleYaml(inv *inventory, contents string) error {
+	var groups map[string]*ansibleYamlGroup
+	if err := yaml.Unmarshal([]byte(contents), &groups); err != nil {
+		return fmt.Errorf("invalid YAML inventory: %w", err)
+	}
+	for _, name := range slices.Sorted(maps.Keys(groups)) {
+		if err := addAnsibleYamlGroup(inv, name, groups[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addAnsibleYamlGroup(inv *inventory, name string, group *ansibleYamlGroup) error {
+	inv.addGroup(name)
+	if group == nil {
+		return nil
+	}
+	for _, pattern := range slices.Sorted(maps.Keys(group.Hosts)) {
+		names, err := expandAnsibleHostPattern(pattern)
+		if err != nil {
+			return fmt.Errorf("group %q: %w", name, err)
+		}
+		address := ansibleHostAddress(group.Hosts[pattern])
+		for _, host := range names {
+			if err := inv.addHost(host, address, name); err != nil {
+				return fmt.Errorf("group %q: %w", name, err)
+			}
+		}
+	}
+	for _, child := range slices.Sorted(maps.Keys(group.Children)) {
+		inv.addChild(name, child)
+		if err := addAnsibleYamlGroup(inv, child, group.Children[child]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ansibleHostAddress returns the address set in the variables of a host, if
+// any.
+func ansibleHostAddress(vars map[string]interface{}) string {
+	for _, key := range []string{"ansible_host", "ansible_ssh_host"} {
+		if v, ok := vars[key]; ok && v != nil {
+			return fmt.Sprint(v)
+		}
+	}
+	return ""
+}
+
+// parseInventoryCsv parses a CSV file with a header row. The name and address
+// columns are required, the optional groups column lists the groups of the
+// host separated by semicolons. Other columns are ignored.
+func parseInventoryCsv(inv *inventory, contents string) error {
+	r := csv.NewReader(strings.NewReader(contents))
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+
+	header, err := r.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return errors.New("the CSV inventory is empty")
+		}
+		return fmt.Errorf("invalid CSV inventory: %w", err)
+	}
+	columns := map[string]int{}
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range []string{NameKey, hostAddressKey} {
+		if _, ok := columns[column]; !ok {
+			return fmt.Errorf("the CSV inventory has no %q column", column)
+		}
+	}
+	groupsColumn, hasGroups := columns["groups"]
+
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid CSV inventory: %w", err)
+		}
+		line, _ := r.FieldPos(0)
+		name := strings.TrimSpace(record[columns[NameKey]])
+		address := strings.TrimSpace(record[columns[hostAddressKey]])
+		if err := inv.addHost(name, address, ""); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		if hasGroups {
+			for _, group := range strings.Split(record[groupsColumn], ";") {
+				if group = strings.TrimSpace(group); group != "" {
+					if err := inv.addHost(name, "", group); err != nil {
+						return fmt.Errorf("line %d: %w", line, err)
+					}
+				}
+			}
+		}
+	}
+}
+
+// inventoryJsonHost is a host of a JSON inventory given as a list of hosts.
+type inventoryJsonHost struct {
+	Name    string   `json:"name"`
+	Address string   `json:"address"`
+	Groups  []string `json:"groups"`
+}
+
+// ansibleJsonGroup is a group of an Ansible inventory in the JSON format
+// output by ansible-inventory --list.
+type ansibleJsonGroup struct {
+	Hosts    []string `json:"hosts"`
+	Children []string `json:"children"`
+}
+
+// parseInventoryJson parses either a list of hosts with their name, address
+// and groups, or an Ansible inventory as output by ansible-inventory --list.
+func parseInventoryJson(inv *inventory, contents string) error {
+	if strings.HasPrefix(strings.TrimSpace(contents), "[") {
+		var hosts []inventoryJsonHost
+		if err := json.Unmarshal([]byte(contents), &hosts); err != nil {
+			return fmt.Errorf("invalid JSON inventory: %w", err)
+		}
+		for i, h := range hosts {
+			if err := inv.addHost(h.Name, h.Address, ""); err != nil {
+				return fmt.Errorf("host %d: %w", i, err)
+			}
+			for _, group := range h.Groups {
+				if err := inv.addHost(h.Name, "", group); err != nil {
+					return fmt.Errorf("host %d: %w", i, err)
+				}
+			}
+		}
+		return nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(contents), &raw); err != nil {
+		return fmt.Errorf("invalid JSON inventory: %w", err)
+	}
+	var meta struct {
+		HostVars map[string]map[string]interface{} `json:"hostvars"`
+	}
+	if m, ok := raw["_meta"]; ok {
+		if err := json.Unmarshal(m, &meta); err != nil {
+			return fmt.Errorf("invalid _meta of the JSON inventory: %w", err)
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(raw)) {
+		if name == "_meta" {
+			continue
+		}
+		var group ansibleJsonGroup
+		if err := json.Unmarshal(raw[name], &group); err != nil {
+			return fmt.Errorf("invalid group %q of the JSON inventory: %w", name, err)
+		}
+		inv.addGroup(name)
+		for _, host := range group.Hosts {
+			if err := inv.addHost(host, ansibleHostAddress(meta.HostVars[host]), name); err != nil {
+				return fmt.Errorf("group %q: %w", name, err)
+			}
+		}
+		for _, child := range group.Children {
+			inv.addChild(name, child)
+		}
+	}
+	// hosts may only be listed in _meta
+	for _, host := range slices.Sorted(maps.Keys(meta.HostVars)) {
+		if err := inv.addHost(host, ansibleHostAddress(meta.HostVars[host]), ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
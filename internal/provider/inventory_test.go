@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInventory(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		contents string
+		want     []inventoryHost
+		err      string
+	}{
+		{
+			name:   "ini",
+			format: inventoryFormatIni,
+			contents: `
+# ungrouped hosts
+bastion ansible_host=192.0.2.10
+
+[web]
+web[01:03].example.com
+db.example.com:2222 ansible_host="10.0.0.5" # inline comment
+
+[db]
+db.example.com
+
+[prod:children]
+web
+db
+
+[prod:vars]
+ansible_user=admin
+`,
+			want: []inventoryHost{
+				{Name: "bastion", Address: "192.0.2.10", Groups: []string{}},
+				{Name: "db.example.com", Address: "10.0.0.5", Groups: []string{"db", "prod", "web"}},
+				{Name: "web01.example.com", Address: "web01.example.com", Groups: []string{"prod", "web"}},
+				{Name: "web02.example.com", Address: "web02.example.com", Groups: []string{"prod", "web"}},
+				{Name: "web03.example.com", Address: "web03.example.com", Groups: []string{"prod", "web"}},
+			},
+		},
+		{
+			name:   "ini conflicting addresses",
+			format: inventoryFormatIni,
+			contents: `
+[web]
+web1 ansible_host=10.0.0.1
+
+[db]
+web1 ansible_host=10.0.0.2
+`,
+			err: `line 6: host "web1" has the addresses "10.0.0.1" and "10.0.0.2"`,
+		},
+		{
+			name:     "ini invalid variable",
+			format:   inventoryFormatIni,
+			contents: "web1 ansible_host\n",
+			err:      `line 1: invalid host variable "ansible_host", expected key=value`,
+		},
+		{
+			name:   "ini quoted variables",
+			format: inventoryFormatIni,
+			contents: `
+[web]
+web1 ansible_host=10.0.0.1 ansible_ssh_common_args="-o ProxyJump bastion"
+web2 ansible_ssh_common_args='-o ProxyCommand="ssh -W %h:%p bastion"' ansible_host="10.0.0.2" # comment
+`,
+			want: []inventoryHost{
+				{Name: "web1", Address: "10.0.0.1", Groups: []string{"web"}},
+				{Name: "web2", Address: "10.0.0.2", Groups: []string{"web"}},
+			},
+		},
+		{
+			name:     "ini unterminated quote",
+			format:   inventoryFormatIni,
+			contents: "web1 ansible_ssh_common_args=\"-o ProxyJump bastion\n",
+			err:      "line 1: no closing quotation",
+		},
+		{
+			name:   "yaml",
+			format: inventoryFormatYaml,
+			contents: `
+all:
+  hosts:
+    bastion:
+      ansible_host: 192.0.2.10
+  children:
+    prod:
+      children:
+        web:
+          hosts:
+            web[a:b]:
+            web-c:
+              ansible_host: 10.0.0.3
+`,
+			want: []inventoryHost{
+				{Name: "bastion", Address: "192.0.2.10", Groups: []string{}},
+				{Name: "web-c", Address: "10.0.0.3", Groups: []string{"prod", "web"}},
+				{Name: "weba", Address: "weba", Groups: []string{"prod", "web"}},
+				{Name: "webb", Address: "webb", Groups: []string{"prod", "web"}},
+			},
+		},
+		{
+			name:     "yaml invalid",
+			format:   inventoryFormatYaml,
+			contents: "all: [",
+			err:      "invalid YAML inventory",
+		},
+		{
+			name:   "csv",
+			format: inventoryFormatCsv,
+			contents: `Name,Address,Groups,Owner
+web1, 10.0.0.1, web;prod, ops
+# decommissioned
+db1,10.0.0.2,,dba
+`,
+			want: []inventoryHost{
+				{Name: "db1", Address: "10.0.0.2", Groups: []string{}},
+				{Name: "web1", Address: "10.0.0.1", Groups: []string{"prod", "web"}},
+			},
+		},
+		{
+			name:     "csv without address",
+			format:   inventoryFormatCsv,
+			contents: "name,ip\nweb1,10.0.0.1\n",
+			err:      `the CSV inventory has no "address" column`,
+		},
+		{
+			name:     "csv without name",
+			format:   inventoryFormatCsv,
+			contents: "name,address\nweb1,10.0.0.1\n,10.0.0.2\n",
+			err:      "line 3: a host has no name",
+		},
+		{
+			name:     "json list",
+			format:   inventoryFormatJson,
+			contents: `[{"name": "web1", "address": "10.0.0.1", "groups": ["web"]}, {"name": "db1", "address": "db1.example.com"}]`,
+			want: []inventoryHost{
+				{Name: "db1", Address: "db1.example.com", Groups: []string{}},
+				{Name: "web1", Address: "10.0.0.1", Groups: []string{"web"}},
+			},
+		},
+		{
+			name:   "json ansible",
+			format: inventoryFormatJson,
+			contents: `{
+	"_meta": {"hostvars": {"web1": {"ansible_host": "10.0.0.1"}, "bastion": {}}},
+	"all": {"children": ["ungrouped", "prod"]},
+	"ungrouped": {"hosts": ["bastion"]},
+	"prod": {"children": ["web"]},
+	"web": {"hosts": ["web1", "web2"]}
+}`,
+			want: []inventoryHost{
+				{Name: "bastion", Address: "bastion", Groups: []string{}},
+				{Name: "web1", Address: "10.0.0.1", Groups: []string{"prod", "web"}},
+				{Name: "web2", Address: "web2", Groups: []string{"prod", "web"}},
+			},
+		},
+		{
+			name:     "unknown format",
+			format:   "toml",
+			contents: "",
+			err:      `unsupported inventory format "toml"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseInventory(tt.format, tt.contents)
+			if tt.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSplitAnsibleHostLine(t *testing.T) {
+	tests := []struct {
+		line string
+		want []string
+		err  string
+	}{
+		{line: "web1  ansible_host=10.0.0.1", want: []string{"web1", "ansible_host=10.0.0.1"}},
+		{line: `web1 args="-o ProxyJump bastion"`, want: []string{"web1", "args=-o ProxyJump bastion"}},
+		{line: `web1 args='a "b" c'`, want: []string{"web1", `args=a "b" c`}},
+		{line: `web1 args="a \"b\" \c"`, want: []string{"web1", `args=a "b" \c`}},
+		{line: `web1 args=a\ b`, want: []string{"web1", "args=a b"}},
+		{line: `web1 empty="" # comment`, want: []string{"web1", "empty="}},
+		{line: `web1 password="a#b"`, want: []string{"web1", "password=a#b"}},
+		{line: `web1 args="-o`, err: "no closing quotation"},
+		{line: `web1 args=\`, err: "no escaped character"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			got, err := splitAnsibleHostLine(tt.line)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandAnsibleHostPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    []string
+		err     string
+	}{
+		{pattern: "web1", want: []string{"web1"}},
+		{pattern: "web[1:3]", want: []string{"web1", "web2", "web3"}},
+		{pattern: "web[08:10]", want: []string{"web08", "web09", "web10"}},
+		{pattern: "web[0:10:5]", want: []string{"web0", "web5", "web10"}},
+		{pattern: "db-[a:c]", want: []string{"db-a", "db-b", "db-c"}},
+		{pattern: "[1:2]-[a:b]", want: []string{"1-a", "1-b", "2-a", "2-b"}},
+		{pattern: "web[3:1]", err: `invalid range in host pattern "web[3:1]", 3 is after 1`},
+		{pattern: "web[1:3:0]", err: `invalid stride in host pattern "web[1:3:0]"`},
+		{pattern: "web[1:z]", err: `invalid range in host pattern "web[1:z]"`},
+		{pattern: "web[1]", err: `invalid host pattern "web[1]"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			got, err := expandAnsibleHostPattern(tt.pattern)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestInventoryFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		source   string
+		wasPath  bool
+		contents string
+		want     string
+		err      bool
+	}{
+		{name: "explicit", format: inventoryFormatCsv, source: "hosts.txt", wasPath: true, want: inventoryFormatCsv},
+		{name: "yaml", source: "inventory/prod.yml", wasPath: true, want: inventoryFormatYaml},
+		{name: "csv", source: "cmdb.CSV", wasPath: true, want: inventoryFormatCsv},
+		{name: "no extension", source: "/etc/ansible/hosts", wasPath: true, want: inventoryFormatIni},
+		{name: "json contents", source: `[{"name": "web1"}]`, contents: `[{"name": "web1"}]`, want: inventoryFormatJson},
+		{name: "ini contents", source: "[web]\nweb1\n", contents: "[web]\nweb1\n", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := inventoryFormat(tt.format, tt.source, tt.wasPath, tt.contents)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
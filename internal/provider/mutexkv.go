@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"sync"
+)
+
+// resourceMutexKV serializes changes made by resources that modify a shared
+// parent resource, such as several attachment resources that add principals
+// to the same role. Boundary rejects updates made with a stale version, so
+// concurrent add/remove calls against the same parent would otherwise race.
+var resourceMutexKV = newMutexKV()
+
+// mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
+// serialize changes across arbitrary collaborators that share knowledge of the
+// keys they must serialize on.
+type mutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{
+		store: make(map[string]*sync.Mutex),
+	}
+}
+
+// Lock locks the mutex for the given key. Caller is responsible for calling
+// Unlock for the same key.
+func (m *mutexKV) Lock(key string) {
+	m.get(key).Lock()
+}
+
+// Unlock unlocks the mutex for the given key. Caller must have called Lock for
+// the same key first.
+func (m *mutexKV) Unlock(key string) {
+	m.get(key).Unlock()
+}
+
+// get returns a mutex for the given key, creating it if it does not exist.
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ephemeralResource is an ephemeral resource served next to the resources and
+// data sources of the SDK provider, which does not support them.
+type ephemeralResource interface {
+	// Schema returns the schema of the ephemeral resource.
+	Schema() *tfprotov5.Schema
+
+	// Validate validates the configuration, which may contain unknown values.
+	Validate(config map[string]tftypes.Value) diag.Diagnostics
+
+	// Open opens the ephemeral resource with a configured provider. The
+	// returned private data is passed to Close.
+	Open(ctx context.Context, meta *metaData, config map[string]tftypes.Value) (result map[string]tftypes.Value, private []byte, diags diag.Diagnostics)
+
+	// Close releases what Open acquired.
+	Close(ctx context.Context, private []byte) diag.Diagnostics
+}
+
+// ephemeralResources returns the ephemeral resources of the provider.
+func ephemeralResources() map[string]ephemeralResource {
+	return map[string]ephemeralResource{
+		"boundary_target_session": newEphemeralTargetSession(),
+	}
+}
+
+// protocolServer serves the SDK provider along with the ephemeral resources.
+type protocolServer struct {
+	*schema.GRPCProviderServer
+
+	provider           *schema.Provider
+	ephemeralResources map[string]ephemeralResource
+}
+
+// NewProtocolServer returns the plugin server of the provider, which adds the
+// ephemeral resources to the SDK provider returned by New.
+func NewProtocolServer() func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return newProtocolServer(New())
+	}
+}
+
+var _ tfprotov5.ProviderServerWithEphemeralResources = (*protocolServer)(nil)
+
+func newProtocolServer(p *schema.Provider) *protocolServer {
+	return &protocolServer{
+		GRPCProviderServer: schema.NewGRPCProviderServer(p),
+		provider:           p,
+		ephemeralResources: ephemeralResources(),
+	}
+}
+
+func (s *protocolServer) GetMetadata(ctx context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	resp, err := s.GRPCProviderServer.GetMetadata(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for typeName := range s.ephemeralResources {
+		resp.EphemeralResources = append(resp.EphemeralResources, tfprotov5.EphemeralResourceMetadata{TypeName: typeName})
+	}
+	slices.SortFunc(resp.EphemeralResources, func(a, b tfprotov5.EphemeralResourceMetadata) int {
+		return strings.Compare(a.TypeName, b.TypeName)
+	})
+	return resp, nil
+}
+
+func (s *protocolServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.GRPCProviderServer.GetProviderSchema(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.EphemeralResourceSchemas == nil {
+		resp.EphemeralResourceSchemas = make(map[string]*tfprotov5.Schema, len(s.ephemeralResources))
+	}
+	for typeName, r := range s.ephemeralResources {
+		resp.EphemeralResourceSchemas[typeName] = r.Schema()
+	}
+	return resp, nil
+}
+
+func (s *protocolServer) ValidateEphemeralResourceConfig(ctx context.Context, req *tfprotov5.ValidateEphemeralResourceConfigRequest) (*tfprotov5.ValidateEphemeralResourceConfigResponse, error) {
+	r, ok := s.ephemeralResources[req.TypeName]
+	if !ok {
+		return s.GRPCProviderServer.ValidateEphemeralResourceConfig(ctx, req)
+	}
+	config, diags := decodeEphemeralConfig(r, req.Config)
+	if !diags.HasError() {
+		diags = append(diags, r.Validate(config)...)
+	}
+	return &tfprotov5.ValidateEphemeralResourceConfigResponse{
+		Diagnostics: protocolDiagnostics(diags),
+	}, nil
+}
+
+func (s *protocolServer) OpenEphemeralResource(ctx context.Context, req *tfprotov5.OpenEphemeralResourceRequest) (*tfprotov5.OpenEphemeralResourceResponse, error) {
+	r, ok := s.ephemeralResources[req.TypeName]
+	if !ok {
+		return s.GRPCProviderServer.OpenEphemeralResource(ctx, req)
+	}
+	resp := &tfprotov5.OpenEphemeralResourceResponse{}
+
+	config, diags := decodeEphemeralConfig(r, req.Config)
+	if diags.HasError() {
+		resp.Diagnostics = protocolDiagnostics(diags)
+		return resp, nil
+	}
+	md, ok := s.provider.Meta().(*metaData)
+	if !ok || md == nil {
+		resp.Diagnostics = protocolDiagnostics(diag.Errorf("the provider must be configured to open %s", req.TypeName))
+		return resp, nil
+	}
+
+	result, private, diags := r.Open(ctx, md, config)
+	if !diags.HasError() {
+		typ := r.Schema().ValueType()
+		value, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, result))
+		if err != nil {
+			diags = append(diags, diag.Errorf("error encoding %s: %v", req.TypeName, err)...)
+			diags = append(diags, r.Close(ctx, private)...)
+		} else {
+			resp.Result = &value
+			resp.Private = private
+		}
+	}
+	resp.Diagnostics = protocolDiagnostics(diags)
+	return resp, nil
+}
+
+func (s *protocolServer) RenewEphemeralResource(ctx context.Context, req *tfprotov5.RenewEphemeralResourceRequest) (*tfprotov5.RenewEphemeralResourceResponse, error) {
+	if _, ok := s.ephemeralResources[req.TypeName]; !ok {
+		return s.GRPCProviderServer.RenewEphemeralResource(ctx, req)
+	}
+	// none of the ephemeral resources ask to be renewed
+	return &tfprotov5.RenewEphemeralResourceResponse{}, nil
+}
+
+func (s *protocolServer) CloseEphemeralResource(ctx context.Context, req *tfprotov5.CloseEphemeralResourceRequest) (*tfprotov5.CloseEphemeralResourceResponse, error) {
+	r, ok := s.ephemeralResources[req.TypeName]
+	if !ok {
+		return s.GRPCProviderServer.CloseEphemeralResource(ctx, req)
+	}
+	return &tfprotov5.CloseEphemeralResourceResponse{
+		Diagnostics: protocolDiagnostics(r.Close(ctx, req.Private)),
+	}, nil
+}
+
+// decodeEphemeralConfig decodes the configuration of an ephemeral resource
+// into its attributes.
+func decodeEphemeralConfig(r ephemeralResource, dv *tfprotov5.DynamicValue) (map[string]tftypes.Value, diag.Diagnostics) {
+	if dv == nil {
+		return nil, diag.Errorf("missing configuration")
+	}
+	value, err := dv.Unmarshal(r.Schema().ValueType())
+	if err != nil {
+		return nil, diag.Errorf("error decoding configuration: %v", err)
+	}
+	var config map[string]tftypes.Value
+	if err := value.As(&config); err != nil {
+		return nil, diag.Errorf("error decoding configuration: %v", err)
+	}
+	return config, nil
+}
+
+// ephemeralString returns the value of a string attribute. It reports false if
+// the value is null or unknown.
+func ephemeralString(config map[string]tftypes.Value, key string) (string, bool) {
+	v, ok := config[key]
+	if !ok || v.IsNull() || !v.IsKnown() {
+		return "", false
+	}
+	var s string
+	if err := v.As(&s); err != nil {
+		return "", false
+	}
+	return s, true
+}
+
+// ephemeralInt returns the value of a number attribute. It reports false if
+// the value is null, unknown or not an integer.
+func ephemeralInt(config map[string]tftypes.Value, key string) (int64, bool) {
+	v, ok := config[key]
+	if !ok || v.IsNull() || !v.IsKnown() {
+		return 0, false
+	}
+	f := new(big.Float)
+	if err := v.As(&f); err != nil || !f.IsInt() {
+		return 0, false
+	}
+	i, acc := f.Int64()
+	return i, acc == big.Exact
+}
+
+// validateEphemeralString runs a ValidateDiagFunc of the SDK on a known
+// string attribute.
+func validateEphemeralString(config map[string]tftypes.Value, key string, f schema.SchemaValidateDiagFunc) diag.Diagnostics {
+	s, ok := ephemeralString(config, key)
+	if !ok {
+		return nil
+	}
+	return f(s, cty.GetAttrPath(key))
+}
+
+// protocolDiagnostics converts diagnostics of the SDK to protocol ones.
+func protocolDiagnostics(diags diag.Diagnostics) []*tfprotov5.Diagnostic {
+	var out []*tfprotov5.Diagnostic
+	for _, d := range diags {
+		pd := &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  d.Summary,
+			Detail:   d.Detail,
+		}
+		if d.Severity == diag.Warning {
+			pd.Severity = tfprotov5.DiagnosticSeverityWarning
+		}
+		if len(d.AttributePath) > 0 {
+			pd.Attribute = tftypes.NewAttributePath()
+			for _, step := range d.AttributePath {
+				switch step := step.(type) {
+				case cty.GetAttrStep:
+					pd.Attribute = pd.Attribute.WithAttributeName(step.Name)
+				case cty.IndexStep:
+					if step.Key.Type() == cty.String {
+						pd.Attribute = pd.Attribute.WithElementKeyString(step.Key.AsString())
+					} else {
+						i, _ := step.Key.AsBigFloat().Int64()
+						pd.Attribute = pd.Attribute.WithElementKeyInt(int(i))
+					}
+				}
+			}
+		}
+		out = append(out, pd)
+	}
+	return out
+}
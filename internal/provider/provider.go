@@ -51,9 +51,10 @@ func New() *schema.Provider {
 				Description: "Can be a heredoc string or a path on disk. If set, the string/file will be parsed as HCL and used with the recovery KMS mechanism. While this is set, it will override any other authentication information; the KMS mechanism will always be used. See Boundary's KMS docs for examples: https://boundaryproject.io/docs/configuration/kms",
 			},
 			"auth_method_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The auth method ID e.g. ampw_1234567890. If not set, the default auth method for the given scope ID will be used.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The auth method ID e.g. ampw_1234567890. If not set, the default auth method for the given scope ID will be used.",
+				ValidateDiagFunc: validateId(authMethodIds...),
 			},
 			"password_auth_method_login_name": {
 				Type:        schema.TypeString,
@@ -88,10 +89,12 @@ func New() *schema.Provider {
 				Description: `Specifies a directory that the Boundary provider can use to write and execute its built-in plugins.`,
 			},
 			"scope_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: `The scope ID for the default auth method.`,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      `The scope ID for the default auth method.`,
+				ValidateDiagFunc: validateId(orgScopeIds...),
 			},
+			grantPolicyKey: grantPolicySchema(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"boundary_account":                                  resourceAccount(),
@@ -112,9 +115,12 @@ func New() *schema.Provider {
 			"boundary_credential_json":                          resourceCredentialJson(),
 			"boundary_managed_group":                            resourceManagedGroup(),
 			"boundary_managed_group_ldap":                       resourceManagedGroupLdap(),
+			"boundary_global_scope":                             resourceGlobalScope(),
 			"boundary_group":                                    resourceGroup(),
+			"boundary_group_member":                             resourceGroupMember(),
 			"boundary_host":                                     resourceHost(),
 			"boundary_host_static":                              resourceHostStatic(),
+			"boundary_host_static_bulk":                         resourceHostStaticBulk(),
 			"boundary_host_catalog":                             resourceHostCatalog(),
 			"boundary_host_catalog_static":                      resourceHostCatalogStatic(),
 			"boundary_host_catalog_plugin":                      resourceHostCatalogPlugin(),
@@ -124,18 +130,31 @@ func New() *schema.Provider {
 			"boundary_policy_storage":                           resourcePolicyStorage(),
 			"boundary_scope_policy_attachment":                  resourceScopePolicyAttachment(),
 			"boundary_role":                                     resourceRole(),
+			"boundary_role_grant":                               resourceRoleGrant(),
+			"boundary_role_grant_scope":                         resourceRoleGrantScope(),
+			"boundary_role_principal":                           resourceRolePrincipal(),
 			"boundary_scope":                                    resourceScope(),
+			"boundary_scope_tree":                               resourceScopeTree(),
+			"boundary_scope_key_rotation":                       resourceScopeKeyRotation(),
+			"boundary_scope_key_version_destruction":            resourceScopeKeyVersionDestruction(),
 			"boundary_storage_bucket":                           resourceStorageBucket(),
 			"boundary_target":                                   resourceTarget(),
+			"boundary_target_tcp":                               resourceTargetTcp(),
+			"boundary_target_ssh":                               resourceTargetSsh(),
+			"boundary_target_credential_source":                 resourceTargetCredentialSource(),
+			"boundary_target_host_source":                       resourceTargetHostSource(),
 			"boundary_user":                                     resourceUser(),
+			"boundary_user_account":                             resourceUserAccount(),
 			"boundary_worker":                                   resourceWorker(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"boundary_account":     dataSourceAccount(),
-			"boundary_auth_method": dataSourceAuthMethod(),
-			"boundary_group":       dataSourceGroup(),
-			"boundary_scope":       dataSourceScope(),
-			"boundary_user":        dataSourceUser(),
+			"boundary_account":            dataSourceAccount(),
+			"boundary_auth_method":        dataSourceAuthMethod(),
+			"boundary_group":              dataSourceGroup(),
+			"boundary_scope":              dataSourceScope(),
+			"boundary_static_inventory":   dataSourceStaticInventory(),
+			"boundary_target_credentials": dataSourceTargetCredentials(),
+			"boundary_user":               dataSourceUser(),
 		},
 	}
 
@@ -147,6 +166,7 @@ func New() *schema.Provider {
 type metaData struct {
 	client             *api.Client
 	recoveryKmsWrapper wrapping.Wrapper
+	grantPolicy        *grantPolicy
 }
 
 func providerAuthenticate(ctx context.Context, d *schema.ResourceData, md *metaData) error {
@@ -291,7 +311,8 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 		client.SetLimiter(5, 5)
 
 		md := &metaData{
-			client: client,
+			client:      client,
+			grantPolicy: grantPolicyFromConfig(d),
 		}
 
 		if err := providerAuthenticate(ctx, d, md); err != nil {
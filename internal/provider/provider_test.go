@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/cap/oidc"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	"github.com/hashicorp/go-kms-wrapping/v2/aead"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -46,6 +47,17 @@ func providerFactories(p **schema.Provider) map[string]func() (*schema.Provider,
 	}
 }
 
+// protoV5ProviderFactories is like providerFactories, but serves the provider
+// along with its ephemeral resources as NewProtocolServer does.
+func protoV5ProviderFactories(p **schema.Provider) map[string]func() (tfprotov5.ProviderServer, error) {
+	*p = New()
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"boundary": func() (tfprotov5.ProviderServer, error) {
+			return newProtocolServer(*p), nil
+		},
+	}
+}
+
 func testWrapper(ctx context.Context, t *testing.T, key string) wrapping.Wrapper {
 	var keyBytes []byte
 	switch key {
@@ -92,6 +104,24 @@ provider "boundary" {
 	return strings.Join(c, "\n")
 }
 
+func testConfigWithGrantPolicy(url, policy string, res ...string) string {
+	provider := fmt.Sprintf(`
+provider "boundary" {
+	addr             = "%s"
+	auth_method_id       = "%s"
+	password_auth_method_login_name = "%s"
+	password_auth_method_password = "%s"
+
+	grant_policy {
+		%s
+	}
+}`, url, tcPAUM, tcLoginName, tcPassword, policy)
+
+	c := []string{provider}
+	c = append(c, res...)
+	return strings.Join(c, "\n")
+}
+
 func testConfigWithToken(url, token string, res ...string) string {
 	provider := fmt.Sprintf(`
 provider "boundary" {
@@ -43,10 +43,11 @@ func resourceAccount() *schema.Resource {
 				Optional:    true,
 			},
 			AuthMethodIdKey: {
-				Description: "The resource ID for the auth method.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The resource ID for the auth method.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(passwordAuthMethodIds),
 			},
 			TypeKey: {
 				Description: "The resource type.",
@@ -46,10 +46,11 @@ func resourceAccountLdap() *schema.Resource {
 				Optional:    true,
 			},
 			AuthMethodIdKey: {
-				Description: "The resource ID for the auth method.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The resource ID for the auth method.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(ldapAuthMethodIds),
 			},
 			TypeKey: {
 				Description: "The resource type.",
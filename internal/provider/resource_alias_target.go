@@ -48,10 +48,11 @@ func resourceAliasTarget() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The scope ID.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(globalScopeIds),
 			},
 			ValueKey: {
 				Description: "The value of the alias.",
@@ -59,16 +60,18 @@ func resourceAliasTarget() *schema.Resource {
 				Required:    true,
 			},
 			DestinationIdKey: {
-				Description: "The destination of the alias.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "The destination of the alias.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateId(targetIds),
 			},
 
 			// Target specific configurable parameters
 			aliasTargetAuthorizeSessionHostIdKey: {
-				Description: "The host id to pass to Boundary when performing an authorize session action.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "The host id to pass to Boundary when performing an authorize session action.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateId(hostIds...),
 			},
 
 			TypeKey: {
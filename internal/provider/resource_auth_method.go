@@ -44,11 +44,14 @@ func resourceAuthMethod() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The scope ID.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(orgScopeIds...),
 			},
+			deletionProtectionKey: deletionProtectionSchema("auth method"),
+			forceDestroyKey:       forceDestroySchema("auth method"),
 			TypeKey: {
 				Description: "The resource type.",
 				Type:        schema.TypeString,
@@ -238,6 +241,10 @@ func resourceAuthMethodUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceAuthMethodDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
+	if diags := checkDeletionProtection(ctx, md, d, "auth method", countAuthMethodChildren); diags.HasError() {
+		return diags
+	}
+
 	amClient := authmethods.NewClient(md.client)
 
 	_, err := amClient.Delete(ctx, d.Id())
@@ -76,11 +76,14 @@ func resourceAuthMethodLdap() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The scope ID.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(orgScopeIds...),
 			},
+			deletionProtectionKey: deletionProtectionSchema("auth method"),
+			forceDestroyKey:       forceDestroySchema("auth method"),
 
 			// LDAP specific configurable parameters
 			authMethodLdapStartTlsField: {
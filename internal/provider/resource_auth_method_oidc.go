@@ -66,11 +66,14 @@ func resourceAuthMethodOidc() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The scope ID.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(orgScopeIds...),
 			},
+			deletionProtectionKey: deletionProtectionSchema("auth method"),
+			forceDestroyKey:       forceDestroySchema("auth method"),
 
 			// OIDC specific configurable parameters
 			authmethodOidcAllowedAudiencesKey: {
@@ -598,6 +601,10 @@ func resourceAuthMethodOidcUpdate(ctx context.Context, d *schema.ResourceData, m
 
 func resourceAuthMethodOidcDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
+	if diags := checkDeletionProtection(ctx, md, d, "auth method", countAuthMethodChildren); diags.HasError() {
+		return diags
+	}
+
 	amClient := authmethods.NewClient(md.client)
 
 	_, err := amClient.Delete(ctx, d.Id())
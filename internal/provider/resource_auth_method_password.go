@@ -49,11 +49,14 @@ func resourceAuthMethodPassword() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The scope ID.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(orgScopeIds...),
 			},
+			deletionProtectionKey: deletionProtectionSchema("auth method"),
+			forceDestroyKey:       forceDestroySchema("auth method"),
 			TypeKey: {
 				Description: "The resource type, hardcoded per resource",
 				Type:        schema.TypeString,
@@ -232,6 +235,10 @@ func resourceAuthMethodPasswordUpdate(ctx context.Context, d *schema.ResourceDat
 
 func resourceAuthMethodPasswordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
+	if diags := checkDeletionProtection(ctx, md, d, "auth method", countAuthMethodChildren); diags.HasError() {
+		return diags
+	}
+
 	amClient := authmethods.NewClient(md.client)
 
 	_, err := amClient.Delete(ctx, d.Id())
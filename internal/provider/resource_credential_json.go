@@ -49,10 +49,11 @@ func resourceCredentialJson() *schema.Resource {
 				Optional:    true,
 			},
 			credentialStoreIdKey: {
-				Description: "The credential store in which to save this json credential.",
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Required:    true,
+				Description:      "The credential store in which to save this json credential.",
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				ValidateDiagFunc: validateId(staticCredentialStoreIds),
 			},
 			credentialJsonObjectKey: {
 				Description: `The object for the this json credential. Either values encoded with the "jsonencode" function, pre-escaped JSON string, or a file`,
@@ -58,10 +58,11 @@ func resourceCredentialLibraryVault() *schema.Resource {
 				Optional:    true,
 			},
 			credentialStoreIdKey: {
-				Description: "The ID of the credential store that this library belongs to.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The ID of the credential store that this library belongs to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(vaultCredentialStoreIds),
 			},
 			credentialLibraryVaultHttpMethodKey: {
 				Description: "The HTTP method the library uses when requesting credentials from Vault. Defaults to 'GET'",
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"math"
 	"net/http"
 
 	"github.com/hashicorp/boundary/api"
@@ -67,10 +68,11 @@ func resourceCredentialLibraryVaultSshCertificate() *schema.Resource {
 				Optional:    true,
 			},
 			credentialStoreIdKey: {
-				Description: "The ID of the credential store that this library belongs to.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The ID of the credential store that this library belongs to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(vaultCredentialStoreIds),
 			},
 			credentialLibraryVaultSshCertificatePathKey: {
 				Description: "The path in Vault to request credentials from.",
@@ -93,9 +95,12 @@ func resourceCredentialLibraryVaultSshCertificate() *schema.Resource {
 				Optional:    true,
 			},
 			credentialLibraryVaultSshCertificateTtlKey: {
-				Description: "Specifies the requested time to live for a certificate returned from the library.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description: "Specifies the requested time to live for a certificate returned from the library, as a " +
+					"duration such as `1h` or `3600s`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateDuration(1, math.MaxInt32),
+				DiffSuppressFunc: suppressEquivalentDuration,
 			},
 			credentialLibraryVaultSshCertificateKeyIdKey: {
 				Description: "Specifies the key id a certificate should have.",
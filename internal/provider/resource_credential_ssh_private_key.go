@@ -51,10 +51,11 @@ func resourceCredentialSshPrivateKey() *schema.Resource {
 				Optional:    true,
 			},
 			credentialStoreIdKey: {
-				Description: "ID of the credential store this credential belongs to.",
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Required:    true,
+				Description:      "ID of the credential store this credential belongs to.",
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				ValidateDiagFunc: validateId(staticCredentialStoreIds),
 			},
 			credentialSshPrivateKeyUsernameKey: {
 				Description: "The username associated with the credential.",
@@ -44,11 +44,14 @@ func resourceCredentialStoreStatic() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope for this credential store.",
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Required:    true,
+				Description:      "The scope for this credential store.",
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				ValidateDiagFunc: validateId(projectIds),
 			},
+			deletionProtectionKey: deletionProtectionSchema("credential store"),
+			forceDestroyKey:       forceDestroySchema("credential store"),
 		},
 	}
 }
@@ -168,6 +171,10 @@ func resourceStaticCredentialStoreUpdate(ctx context.Context, d *schema.Resource
 
 func resourceStaticCredentialStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
+	if diags := checkDeletionProtection(ctx, md, d, "credential store", countCredentialStoreStaticChildren); diags.HasError() {
+		return diags
+	}
+
 	client := credentialstores.NewClient(md.client)
 
 	_, err := client.Delete(ctx, d.Id())
@@ -67,11 +67,14 @@ func resourceCredentialStoreVault() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope for this credential store.",
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Required:    true,
+				Description:      "The scope for this credential store.",
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				ValidateDiagFunc: validateId(projectIds),
 			},
+			deletionProtectionKey: deletionProtectionSchema("credential store"),
+			forceDestroyKey:       forceDestroySchema("credential store"),
 			credentialStoreVaultAddressKey: {
 				Description: "The address to Vault server. This should be a complete URL such as 'https://127.0.0.1:8200'",
 				Type:        schema.TypeString,
@@ -125,9 +128,10 @@ func resourceCredentialStoreVault() *schema.Resource {
 				Computed:    true,
 			},
 			credentialStoreVaultWorkerFilterKey: {
-				Description: "HCP Only. A filter used to control which PKI workers can handle Vault requests. This allows the use of private Vault instances with Boundary.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "HCP Only. A filter used to control which PKI workers can handle Vault requests. This allows the use of private Vault instances with Boundary.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateWorkerFilter,
 			},
 		},
 	}
@@ -399,6 +403,10 @@ func resourceCredentialStoreVaultUpdate(ctx context.Context, d *schema.ResourceD
 
 func resourceCredentialStoreVaultDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
+	if diags := checkDeletionProtection(ctx, md, d, "credential store", countCredentialStoreVaultChildren); diags.HasError() {
+		return diags
+	}
+
 	client := credentialstores.NewClient(md.client)
 
 	_, err := client.Delete(ctx, d.Id())
@@ -49,10 +49,11 @@ func resourceCredentialUsernamePassword() *schema.Resource {
 				Optional:    true,
 			},
 			credentialStoreIdKey: {
-				Description: "The credential store in which to save this username/password credential.",
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Required:    true,
+				Description:      "The credential store in which to save this username/password credential.",
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				ValidateDiagFunc: validateId(staticCredentialStoreIds),
 			},
 			credentialUsernamePasswordUsernameKey: {
 				Description: "The username of this username/password credential.",
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	globalScopeId              = "global"
+	globalScopeStoragePolicyId = "storage_policy_id"
+)
+
+func resourceGlobalScope() *schema.Resource {
+	return &schema.Resource{
+		Description: "The global scope resource allows you to manage the settings of the Boundary global scope, " +
+			"which always exists. Creating the resource adopts the global scope and destroying it only removes " +
+			"it from the state.",
+
+		CreateContext: resourceGlobalScopeCreate,
+		ReadContext:   resourceGlobalScopeRead,
+		UpdateContext: resourceGlobalScopeUpdate,
+		DeleteContext: resourceGlobalScopeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGlobalScopeImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the global scope, always `global`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			NameKey: {
+				Description: "The global scope name. Left unchanged if unset, cleared if set to an empty string.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			DescriptionKey: {
+				Description: "The global scope description. Left unchanged if unset, cleared if set to an empty string.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			globalScopeStoragePolicyId: {
+				Description: "The ID of the storage policy attached to the global scope. Left unchanged if unset, so that " +
+					"the attachment can be managed by `boundary_scope_policy_attachment`, and detached if set to an empty string.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateId(storagePolicyIds),
+			},
+		},
+	}
+}
+
+func resourceGlobalScopeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(globalScopeId)
+	return resourceGlobalScopeUpdate(ctx, d, meta)
+}
+
+func resourceGlobalScopeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	srr, err := scp.Read(ctx, globalScopeId)
+	if err != nil {
+		return diag.Errorf("error reading global scope: %v", err)
+	}
+	s := srr.GetItem()
+
+	if err := d.Set(NameKey, s.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(DescriptionKey, s.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(globalScopeStoragePolicyId, s.StoragePolicyId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGlobalScopeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	opts := []scopes.Option{}
+	if d.HasChange(NameKey) {
+		opts = append(opts, scopes.DefaultName())
+		if name, ok := d.GetOk(NameKey); ok {
+			opts = append(opts, scopes.WithName(name.(string)))
+		}
+	}
+	if d.HasChange(DescriptionKey) {
+		opts = append(opts, scopes.DefaultDescription())
+		if desc, ok := d.GetOk(DescriptionKey); ok {
+			opts = append(opts, scopes.WithDescription(desc.(string)))
+		}
+	}
+	if len(opts) > 0 {
+		opts = append(opts, scopes.WithAutomaticVersioning(true))
+		if _, err := scp.Update(ctx, globalScopeId, 0, opts...); err != nil {
+			return diag.Errorf("error updating global scope: %v", err)
+		}
+	}
+
+	if d.HasChange(globalScopeStoragePolicyId) {
+		srr, err := scp.Read(ctx, globalScopeId)
+		if err != nil {
+			return diag.Errorf("error reading global scope: %v", err)
+		}
+		current := srr.GetItem().StoragePolicyId
+		policyId := d.Get(globalScopeStoragePolicyId).(string)
+		if current != policyId {
+			if current != "" {
+				if _, err := scp.DetachStoragePolicy(ctx, globalScopeId, 0, scopes.WithAutomaticVersioning(true)); err != nil {
+					return diag.Errorf("error detaching storage policy from global scope: %v", err)
+				}
+			}
+			if policyId != "" {
+				if _, err := scp.AttachStoragePolicy(ctx, globalScopeId, 0, policyId, scopes.WithAutomaticVersioning(true)); err != nil {
+					return diag.Errorf("error attaching storage policy to global scope: %v", err)
+				}
+			}
+		}
+	}
+
+	return resourceGlobalScopeRead(ctx, d, meta)
+}
+
+func resourceGlobalScopeDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// The global scope cannot be deleted, so this only removes the resource
+	// from the state.
+	return nil
+}
+
+func resourceGlobalScopeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() != globalScopeId {
+		return nil, fmt.Errorf("the ID of the global scope is %q, got %q", globalScopeId, d.Id())
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	globalScopeSettings = `
+resource "boundary_global_scope" "global" {
+	name        = "global"
+	description = "managed global scope"
+}`
+
+	globalScopeSettingsUpdate = `
+resource "boundary_global_scope" "global" {
+	description = "updated global scope"
+}`
+
+	globalScopeSettingsClearName = `
+resource "boundary_global_scope" "global" {
+	name        = ""
+	description = "updated global scope"
+}`
+)
+
+func TestAccGlobalScope(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		// destroying the resource must leave the global scope untouched
+		CheckDestroy: testAccCheckGlobalScopeDescription(provider, "updated global scope"),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, globalScopeSettings),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("boundary_global_scope.global", IDKey, "global"),
+					testAccCheckGlobalScopeDescription(provider, "managed global scope"),
+				),
+			},
+			importStep("boundary_global_scope.global"),
+			{
+				// an unset name is left unchanged
+				Config: testConfig(url, globalScopeSettingsUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("boundary_global_scope.global", NameKey, "global"),
+					testAccCheckGlobalScopeDescription(provider, "updated global scope"),
+				),
+			},
+			{
+				// an empty name clears it
+				Config: testConfig(url, globalScopeSettingsClearName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("boundary_global_scope.global", NameKey, ""),
+					testAccCheckGlobalScopeDescription(provider, "updated global scope"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalScopeDescription(testProvider *schema.Provider, want string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		md := testProvider.Meta().(*metaData)
+		srr, err := scopes.NewClient(md.client).Read(context.Background(), globalScopeId)
+		if err != nil {
+			return fmt.Errorf("error reading global scope: %w", err)
+		}
+		if got := srr.GetItem().Description; got != want {
+			return fmt.Errorf("global scope description is %q, want %q", got, want)
+		}
+		return nil
+	}
+}
@@ -42,16 +42,22 @@ func resourceGroup() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(anyScopeIds...),
 			},
 			GroupMemberIdsKey: {
-				Description: "Resource IDs for group members, these are most likely boundary users.",
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Resource IDs for group members, these are most likely boundary users. Updates only add " +
+					"or remove the members that changed. Members added by `boundary_group_member` show up as drift unless " +
+					"this attribute is in `ignore_changes`.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateId(userIds),
+				},
 			},
 		},
 	}
@@ -203,25 +209,26 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
-	// The above call may not actually happen, so we use d.Id() and automatic
-	// versioning here
+	// Members may also be added by boundary_group_member, so only the members
+	// that changed are added or removed. The above call may not actually
+	// happen, so we use d.Id() and automatic versioning here.
 	if d.HasChange(GroupMemberIdsKey) {
-		var memberIds []string
-		if membersVal, ok := d.GetOk(GroupMemberIdsKey); ok {
-			members := membersVal.(*schema.Set).List()
-			for _, member := range members {
-				memberIds = append(memberIds, member.(string))
+		resourceMutexKV.Lock(d.Id())
+		defer resourceMutexKV.Unlock(d.Id())
+
+		removed, added := setChanges(d, GroupMemberIdsKey)
+		if len(removed) > 0 {
+			_, err := grps.RemoveMembers(ctx, d.Id(), 0, removed, groups.WithAutomaticVersioning(true))
+			if err != nil {
+				return diag.Errorf("error removing members from group: %v", err)
 			}
-
-		}
-		_, err := grps.SetMembers(ctx, d.Id(), 0, memberIds, groups.WithAutomaticVersioning(true))
-		if err != nil {
-			return diag.Errorf("error updating members in group: %v", err)
 		}
-		if err := d.Set(GroupMemberIdsKey, memberIds); err != nil {
-			return diag.FromErr(err)
+		if len(added) > 0 {
+			_, err := grps.AddMembers(ctx, d.Id(), 0, added, groups.WithAutomaticVersioning(true))
+			if err != nil {
+				return diag.Errorf("error adding members to group: %v", err)
+			}
 		}
-
 	}
 
 	return nil
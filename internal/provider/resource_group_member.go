@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/groups"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	groupIdKey       = "group_id"
+	groupMemberIdKey = "member_id"
+)
+
+func resourceGroupMember() *schema.Resource {
+	return &schema.Resource{
+		Description: "The group member resource allows you to add a single member to a Boundary group " +
+			"without managing the full set of members of that group. The `boundary_group` resource must omit " +
+			"`member_ids` and list it in `ignore_changes`, otherwise both resources will fight over the members.",
+
+		CreateContext: resourceGroupMemberCreate,
+		ReadContext:   resourceGroupMemberRead,
+		DeleteContext: resourceGroupMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGroupMemberImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the group member, in the form `<group_id>:<member_id>`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			groupIdKey: {
+				Description:      "The ID of the group to add the member to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(groupIds),
+			},
+			groupMemberIdKey: {
+				Description:      "The ID of the member to add to the group, most likely a boundary user.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(userIds),
+			},
+		},
+	}
+}
+
+func resourceGroupMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	grps := groups.NewClient(md.client)
+
+	groupId := d.Get(groupIdKey).(string)
+	memberId := d.Get(groupMemberIdKey).(string)
+
+	resourceMutexKV.Lock(groupId)
+	defer resourceMutexKV.Unlock(groupId)
+
+	_, err := grps.AddMembers(ctx, groupId, 0, []string{memberId}, groups.WithAutomaticVersioning(true))
+	if err != nil {
+		return diag.Errorf("error adding member to group: %v", err)
+	}
+
+	d.SetId(attachmentId(groupId, memberId))
+
+	return nil
+}
+
+func resourceGroupMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	grps := groups.NewClient(md.client)
+
+	groupId := d.Get(groupIdKey).(string)
+	memberId := d.Get(groupMemberIdKey).(string)
+
+	g, err := grps.Read(ctx, groupId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			// the group is gone, destroy this resource
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading group: %v", err)
+	}
+	if g == nil {
+		return diag.Errorf("group nil after read")
+	}
+
+	for _, id := range g.GetItem().MemberIds {
+		if id == memberId {
+			return nil
+		}
+	}
+
+	// this member is no longer in the group, destroy this resource
+	d.SetId("")
+	return nil
+}
+
+func resourceGroupMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	grps := groups.NewClient(md.client)
+
+	groupId := d.Get(groupIdKey).(string)
+	memberId := d.Get(groupMemberIdKey).(string)
+
+	resourceMutexKV.Lock(groupId)
+	defer resourceMutexKV.Unlock(groupId)
+
+	_, err := grps.RemoveMembers(ctx, groupId, 0, []string{memberId}, groups.WithAutomaticVersioning(true))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error removing member from group: %v", err)
+	}
+
+	return nil
+}
+
+func resourceGroupMemberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitAttachmentId(d.Id(), 2)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set(groupIdKey, parts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set(groupMemberIdKey, parts[1]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
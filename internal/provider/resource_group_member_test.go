@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	orgGroupWithoutMembers = `
+resource "boundary_user" "org1" {
+	description = "org1"
+	scope_id    = boundary_scope.org1.id
+	depends_on  = [boundary_role.org1_admin]
+}
+
+resource "boundary_user" "bar" {
+	description = "bar"
+	scope_id    = boundary_scope.org1.id
+	depends_on  = [boundary_role.org1_admin]
+}
+
+resource "boundary_group" "shared" {
+	description = "shared group"
+	scope_id    = boundary_scope.org1.id
+	depends_on  = [boundary_role.org1_admin]
+
+	lifecycle {
+		ignore_changes = [member_ids]
+	}
+}`
+
+	groupMemberOrg1 = `
+resource "boundary_group_member" "org1" {
+	group_id  = boundary_group.shared.id
+	member_id = boundary_user.org1.id
+}`
+
+	groupMemberBar = `
+resource "boundary_group_member" "bar" {
+	group_id  = boundary_group.shared.id
+	member_id = boundary_user.bar.id
+}`
+)
+
+func TestAccGroupMember(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckGroupResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// test create
+				Config: testConfig(url, fooOrg, orgGroupWithoutMembers, groupMemberOrg1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupResourceExists(provider, "boundary_group.shared"),
+					testAccCheckGroupResourceMembersSet(provider, "boundary_group.shared", []string{"boundary_user.org1"}),
+				),
+			},
+			importStep("boundary_group_member.org1"),
+			{
+				// add a second member, the first one must be left untouched
+				Config: testConfig(url, fooOrg, orgGroupWithoutMembers, groupMemberOrg1, groupMemberBar),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupResourceMembersSet(provider, "boundary_group.shared", []string{"boundary_user.org1", "boundary_user.bar"}),
+				),
+			},
+			importStep("boundary_group_member.bar"),
+			{
+				// remove the first member
+				Config: testConfig(url, fooOrg, orgGroupWithoutMembers, groupMemberBar),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupResourceMembersSet(provider, "boundary_group.shared", []string{"boundary_user.bar"}),
+				),
+			},
+		},
+	})
+}
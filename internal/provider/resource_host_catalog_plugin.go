@@ -58,18 +58,22 @@ func resourceHostCatalogPlugin() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID in which the resource is created.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The scope ID in which the resource is created.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(projectIds),
 			},
+			deletionProtectionKey: deletionProtectionSchema("host catalog"),
+			forceDestroyKey:       forceDestroySchema("host catalog"),
 			PluginIdKey: {
-				Description:   "The ID of the plugin that should back the resource. This or " + PluginNameKey + " must be defined.",
-				Type:          schema.TypeString,
-				ConflictsWith: []string{PluginNameKey},
-				Optional:      true,
-				ForceNew:      true,
-				Computed:      true, // If name is provided this will be computed
+				Description:      "The ID of the plugin that should back the resource. This or " + PluginNameKey + " must be defined.",
+				Type:             schema.TypeString,
+				ConflictsWith:    []string{PluginNameKey},
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true, // If name is provided this will be computed
+				ValidateDiagFunc: validateId(pluginIds),
 			},
 			PluginNameKey: {
 				Description:   "The name of the plugin that should back the resource. This or " + PluginIdKey + " must be defined.",
@@ -119,9 +123,10 @@ func resourceHostCatalogPlugin() *schema.Resource {
 				Computed:    true,
 			},
 			WorkerFilterKey: {
-				Description: "HCP Only. A filter used to control which PKI workers can handle dynamic host catalog requests.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "HCP Only. A filter used to control which PKI workers can handle dynamic host catalog requests.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateWorkerFilter,
 			},
 			internalSecretsConfigHmacKey: {
 				Description: "Internal only. HMAC of (serverSecretsHmac + config secrets). Used for proper secrets handling.",
@@ -663,6 +668,10 @@ func resourceHostCatalogPluginUpdate(ctx context.Context, d *schema.ResourceData
 
 func resourceHostCatalogPluginDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
+	if diags := checkDeletionProtection(ctx, md, d, "host catalog", countHostCatalogPluginChildren); diags.HasError() {
+		return diags
+	}
+
 	hcClient := hostcatalogs.NewClient(md.client)
 
 	_, err := hcClient.Delete(ctx, d.Id())
@@ -47,11 +47,14 @@ func resourceHostCatalog() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID in which the resource is created.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The scope ID in which the resource is created.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(projectIds),
 			},
+			deletionProtectionKey: deletionProtectionSchema("host catalog"),
+			forceDestroyKey:       forceDestroySchema("host catalog"),
 			TypeKey: {
 				Description: "The host catalog type. Only `static` is supported.",
 				Type:        schema.TypeString,
@@ -93,11 +96,14 @@ func resourceHostCatalogStatic() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID in which the resource is created.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The scope ID in which the resource is created.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(projectIds),
 			},
+			deletionProtectionKey: deletionProtectionSchema("host catalog"),
+			forceDestroyKey:       forceDestroySchema("host catalog"),
 		},
 	}
 }
@@ -249,6 +255,10 @@ func resourceHostCatalogStaticUpdate(hasTypeKey bool) schema.UpdateContextFunc {
 
 func resourceHostCatalogStaticDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
+	if diags := checkDeletionProtection(ctx, md, d, "host catalog", countHostCatalogStaticChildren); diags.HasError() {
+		return diags
+	}
+
 	hcClient := hostcatalogs.NewClient(md.client)
 
 	_, err := hcClient.Delete(ctx, d.Id())
@@ -7,11 +7,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
 	"net/http"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/hostsets"
-	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -59,10 +60,11 @@ func resourceHostSetPlugin() *schema.Resource {
 				Default:     hostSetTypePlugin,
 			},
 			HostCatalogIdKey: {
-				Description: "The catalog for the host set.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The catalog for the host set.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(pluginHostCatalogIds),
 			},
 			PreferredEndpointsKey: {
 				Description: "The ordered list of preferred endpoints.",
@@ -71,18 +73,21 @@ func resourceHostSetPlugin() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			SyncIntervalSecondsKey: {
-				Description: "The value to set for the sync interval seconds.",
-				Type:        schema.TypeInt,
-				Optional:    true,
-				ValidateDiagFunc: func(in interface{}, _ cty.Path) diag.Diagnostics {
-					val := in.(int)
-					switch {
-					case val >= -1:
-						return nil
-					default:
-						return diag.Errorf("invalid value for sync_interval_seconds")
-					}
-				},
+				Description: "The interval between syncs of the host set in seconds, or -1 to disable automatic " +
+					"syncing. Defaults to the interval of the controller when unset or 0.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validateSyncIntervalSeconds,
+				ConflictsWith:    []string{syncIntervalKey},
+			},
+			syncIntervalKey: {
+				Description: "The interval between syncs of the host set as a duration string, such as `10m`. An " +
+					"alternative to `sync_interval_seconds`, which must be used to disable automatic syncing.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateDuration(1, math.MaxInt32),
+				DiffSuppressFunc: suppressEquivalentDuration,
+				ConflictsWith:    []string{SyncIntervalSecondsKey},
 			},
 			AttributesJsonKey: {
 				Description: `The attributes for the host set. Either values encoded with the "jsonencode" function, pre-escaped JSON string, or a file:// or env:// path. Set to a string "null" or remove the block to clear all attributes in the host set.`,
@@ -120,8 +125,22 @@ func setFromHostSetPluginResponseMap(d *schema.ResourceData, raw map[string]inte
 	if err := d.Set(TypeKey, raw[TypeKey]); err != nil {
 		return err
 	}
-	if err := d.Set(SyncIntervalSecondsKey, raw[SyncIntervalSecondsKey]); err != nil {
-		return err
+	// Only the attribute used in the configuration is set, the other one
+	// would show up as a change otherwise.
+	if interval, _ := d.Get(syncIntervalKey).(string); interval != "" {
+		var seconds int64
+		if v, ok := raw[SyncIntervalSecondsKey].(json.Number); ok {
+			seconds, _ = v.Int64()
+		}
+		if configured, err := parseDuration(interval); err != nil || configured != seconds {
+			if err := d.Set(syncIntervalKey, formatSeconds(seconds)); err != nil {
+				return err
+			}
+		}
+	} else {
+		if err := d.Set(SyncIntervalSecondsKey, raw[SyncIntervalSecondsKey]); err != nil {
+			return err
+		}
 	}
 	if err := d.Set(PreferredEndpointsKey, raw[PreferredEndpointsKey]); err != nil {
 		return err
@@ -184,9 +203,11 @@ func resourceHostSetPluginCreate(ctx context.Context, d *schema.ResourceData, me
 		opts = append(opts, hostsets.WithDescription(descStr))
 	}
 
-	syncIntervalSecondsVal, ok := d.GetOk(SyncIntervalSecondsKey)
+	syncIntervalSecondsInt, ok, err := hostSetSyncIntervalSeconds(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if ok {
-		syncIntervalSecondsInt := syncIntervalSecondsVal.(int)
 		opts = append(opts, hostsets.WithSyncIntervalSeconds(int32(syncIntervalSecondsInt)))
 	}
 
@@ -283,11 +304,13 @@ func resourceHostSetPluginUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if d.HasChange(SyncIntervalSecondsKey) {
+	if d.HasChange(SyncIntervalSecondsKey) || d.HasChange(syncIntervalKey) {
 		opts = append(opts, hostsets.DefaultSyncIntervalSeconds())
-		syncIntervalSecondsVal, ok := d.GetOk(SyncIntervalSecondsKey)
+		syncIntervalSecondsInt, ok, err := hostSetSyncIntervalSeconds(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		if ok {
-			syncIntervalSecondsInt := syncIntervalSecondsVal.(int)
 			opts = append(opts, hostsets.WithSyncIntervalSeconds(int32(syncIntervalSecondsInt)))
 		}
 	}
@@ -346,6 +369,22 @@ func resourceHostSetPluginUpdate(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
+// hostSetSyncIntervalSeconds returns the sync interval of the host set in
+// seconds, from either sync_interval or sync_interval_seconds.
+func hostSetSyncIntervalSeconds(d *schema.ResourceData) (int, bool, error) {
+	if v, ok := d.GetOk(syncIntervalKey); ok {
+		seconds, err := parseDuration(v.(string))
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid %q: %w", syncIntervalKey, err)
+		}
+		return int(seconds), true, nil
+	}
+	if v, ok := d.GetOk(SyncIntervalSecondsKey); ok {
+		return v.(int), true, nil
+	}
+	return 0, false, nil
+}
+
 func resourceHostSetPluginDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
 	hsClient := hostsets.NewClient(md.client)
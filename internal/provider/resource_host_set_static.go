@@ -5,17 +5,20 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/hostsets"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
-	hostSetHostIdsKey = "host_ids"
-	hostSetTypeStatic = "static"
+	hostSetHostIdsKey   = "host_ids"
+	hostSetHostNamesKey = "host_names"
+	hostSetTypeStatic   = "static"
 )
 
 func resourceHostSet() *schema.Resource {
@@ -54,16 +57,20 @@ func resourceHostSet() *schema.Resource {
 				ForceNew:    true,
 			},
 			HostCatalogIdKey: {
-				Description: "The catalog for the host set.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The catalog for the host set.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(staticHostCatalogIds),
 			},
 			hostSetHostIdsKey: {
 				Description: "The list of host IDs contained in this set.",
 				Type:        schema.TypeSet,
 				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateId(staticHostIds),
+				},
 			},
 		},
 	}
@@ -107,19 +114,89 @@ func resourceHostSetStatic() *schema.Resource {
 				Default:     hostSetTypeStatic,
 			},
 			HostCatalogIdKey: {
-				Description: "The catalog for the host set.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The catalog for the host set.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(staticHostCatalogIds),
 			},
 			hostSetHostIdsKey: {
-				Description: "The list of host IDs contained in this set.",
+				Description: "The list of host IDs contained in this set. Also holds the IDs `host_names` resolve to.",
 				Type:        schema.TypeSet,
 				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateId(staticHostIds),
+				},
+			},
+			hostSetHostNamesKey: {
+				Description: "The names of hosts of the host catalog to add to this set. They are resolved to IDs " +
+					"when planning and added to `host_ids`. Use `depends_on` when the hosts are created in the same " +
+					"configuration.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
 			},
 		},
+		CustomizeDiff: resourceHostSetStaticCustomizeDiff,
+	}
+}
+
+// resourceHostSetStaticCustomizeDiff plans host_ids with the IDs host_names
+// resolve to. host_ids is computed to hold them, so it also has to be cleared
+// here when it is removed from the configuration. The IDs are left unknown
+// when a host does not exist yet, to be resolved again when applying.
+func resourceHostSetStaticCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	configured, known := rawConfigStrings(d.GetRawConfig(), hostSetHostIdsKey)
+	names := setToStrings(d.Get(hostSetHostNamesKey))
+	if len(names) == 0 && d.NewValueKnown(hostSetHostNamesKey) {
+		if known && len(configured) == 0 && d.Get(hostSetHostIdsKey).(*schema.Set).Len() > 0 {
+			return d.SetNew(hostSetHostIdsKey, []string{})
+		}
+		return nil
+	}
+
+	if !known || !d.NewValueKnown(hostSetHostNamesKey) || !d.NewValueKnown(HostCatalogIdKey) {
+		return d.SetNewComputed(hostSetHostIdsKey)
+	}
+	resolved, err := hostIdsByName(ctx, meta.(*metaData).client, d.Get(HostCatalogIdKey).(string), names)
+	var notFound *nameNotFoundError
+	switch {
+	case errors.As(err, &notFound):
+		return d.SetNewComputed(hostSetHostIdsKey)
+	case err != nil:
+		return err
+	}
+	return d.SetNew(hostSetHostIdsKey, mergeIds(configured, resolved))
+}
+
+// hostSetStaticHostIds returns the host IDs to set on a static host set when
+// applying. Without host_names these are the ones of host_ids, the names are
+// resolved again otherwise since their IDs may not have been known when
+// planning.
+func hostSetStaticHostIds(ctx context.Context, d *schema.ResourceData, client *api.Client) ([]string, error) {
+	var names []string
+	if v, ok := d.GetOk(hostSetHostNamesKey); ok {
+		names = setToStrings(v)
+	}
+	if len(names) == 0 {
+		var hostIds []string
+		if hostIdsVal, ok := d.GetOk(hostSetHostIdsKey); ok {
+			hostIds = setToStrings(hostIdsVal)
+		}
+		return hostIds, nil
 	}
+
+	configured, _ := rawConfigStrings(d.GetRawConfig(), hostSetHostIdsKey)
+	resolved, err := hostIdsByName(ctx, client, d.Get(HostCatalogIdKey).(string), names)
+	if err != nil {
+		return nil, err
+	}
+	return mergeIds(configured, resolved), nil
 }
 
 func setFromHostSetStaticResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
@@ -152,13 +229,9 @@ func resourceHostSetStaticCreate(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("no host catalog ID provided")
 	}
 
-	var hostIds []string
-	if hostIdsVal, ok := d.GetOk(hostSetHostIdsKey); ok {
-		list := hostIdsVal.(*schema.Set).List()
-		hostIds = make([]string, 0, len(list))
-		for _, i := range list {
-			hostIds = append(hostIds, i.(string))
-		}
+	hostIds, err := hostSetStaticHostIds(ctx, d, md.client)
+	if err != nil {
+		return diag.Errorf("error resolving host names: %v", err)
 	}
 
 	opts := []hostsets.Option{}
@@ -292,14 +365,11 @@ func resourceHostSetStaticUpdate(ctx context.Context, d *schema.ResourceData, me
 	// The above call may not actually happen, so we use d.Id() and automatic
 	// versioning here
 	if d.HasChange(hostSetHostIdsKey) {
-		var hostIds []string
-		if hostIdsVal, ok := d.GetOk(hostSetHostIdsKey); ok {
-			hosts := hostIdsVal.(*schema.Set).List()
-			for _, host := range hosts {
-				hostIds = append(hostIds, host.(string))
-			}
+		hostIds, err := hostSetStaticHostIds(ctx, d, md.client)
+		if err != nil {
+			return diag.Errorf("error resolving host names: %v", err)
 		}
-		_, err := hsClient.SetHosts(ctx, d.Id(), 0, hostIds, hostsets.WithAutomaticVersioning(true))
+		_, err = hsClient.SetHosts(ctx, d.Id(), 0, hostIds, hostsets.WithAutomaticVersioning(true))
 		if err != nil {
 			return diag.Errorf("error updating hosts in host set: %v", err)
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/boundary/api"
@@ -137,6 +138,79 @@ func testAccCheckHostSetStaticResourceExists(testProvider *schema.Provider, name
 	}
 }
 
+const hostSetStaticWithHostNames = `
+resource "boundary_host_catalog_static" "foo" {
+	name       = "test"
+	scope_id   = boundary_scope.proj1.id
+	depends_on = [boundary_role.proj1_admin]
+}
+
+resource "boundary_host_static" "foo" {
+	name            = "foo"
+	host_catalog_id = boundary_host_catalog_static.foo.id
+	address         = "10.0.0.1"
+}
+
+resource "boundary_host_static" "bar" {
+	name            = "bar"
+	host_catalog_id = boundary_host_catalog_static.foo.id
+	address         = "10.0.0.2"
+}
+
+resource "boundary_host_set_static" "foo" {
+	name            = "test"
+	host_catalog_id = boundary_host_catalog_static.foo.id
+	host_names      = [%s]
+	depends_on      = [boundary_host_static.foo, boundary_host_static.bar]
+}`
+
+func TestAccHostSetStatic_HostNames(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	const name = "boundary_host_set_static.foo"
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckHostSetResourceDestroy(t, provider, "boundary_host_set_static"),
+		Steps: []resource.TestStep{
+			{
+				// the hosts do not exist when planning, they are resolved when
+				// applying
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(hostSetStaticWithHostNames, `"foo"`)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostSetStaticResourceExists(provider, name),
+					testAccCheckHostSetStaticHostIDsSet(provider, name, []string{"boundary_host_static.foo"}),
+					resource.TestCheckResourceAttr(name, "host_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(name, "host_ids.*", "boundary_host_static.foo", IDKey),
+				),
+			},
+			// host names are imported as host_ids
+			importStep(name, hostSetHostNamesKey),
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(hostSetStaticWithHostNames, `"foo", "bar"`)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostSetStaticHostIDsSet(provider, name, []string{"boundary_host_static.foo", "boundary_host_static.bar"}),
+					resource.TestCheckResourceAttr(name, "host_ids.#", "2"),
+				),
+			},
+			{
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(hostSetStaticWithHostNames, `"baz"`)),
+				ExpectError: regexp.MustCompile(`no host named "baz" in host catalog`),
+			},
+			{
+				// removing the names removes the hosts they resolved to
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(hostSetStaticWithHostNames, "")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "host_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckHostSetStaticHostIDsSet(testProvider *schema.Provider, name string, wantHostIDs []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
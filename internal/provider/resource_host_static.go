@@ -54,8 +54,9 @@ func resourceHost() *schema.Resource {
 				ForceNew:    true,
 			},
 			HostCatalogIdKey: {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateId(staticHostCatalogIds),
 			},
 			hostAddressKey: {
 				Description: "The static address of the host resource as `<IP>` (note: port assignment occurs in the target resource definition, do not add :port here) or a domain name.",
@@ -104,8 +105,9 @@ func resourceHostStatic() *schema.Resource {
 				Default:     "static",
 			},
 			HostCatalogIdKey: {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateId(staticHostCatalogIds),
 			},
 			hostAddressKey: {
 				Description: "The static address of the host resource as `<IP>` (note: port assignment occurs in the target resource definition, do not add :port here) or a domain name.",
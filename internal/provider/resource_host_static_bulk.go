@@ -0,0 +1,408 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	hostStaticBulkHostsKey     = "hosts"
+	hostStaticBulkHostIdsKey   = "host_ids"
+	hostStaticBulkHostSetIdKey = "host_set_id"
+)
+
+func resourceHostStaticBulk() *schema.Resource {
+	return &schema.Resource{
+		Description: "The boundary_host_static_bulk resource manages many static hosts of a host catalog as a " +
+			"single resource, keyed by their name. Only the hosts that changed are created, updated or deleted, " +
+			"and they are read with a single paginated list call. Hosts of the catalog that are not managed by " +
+			"the resource are not affected.",
+
+		CreateContext: resourceHostStaticBulkCreate,
+		ReadContext:   resourceHostStaticBulkRead,
+		UpdateContext: resourceHostStaticBulkUpdate,
+		DeleteContext: resourceHostStaticBulkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHostStaticBulkImport,
+		},
+		CustomizeDiff: resourceHostStaticBulkCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the resource, in the form `<host_catalog_id>:<suffix>` where the suffix is " +
+					"random. It does not change when hosts are added or removed.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			HostCatalogIdKey: {
+				Description:      "The catalog of the hosts.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(staticHostCatalogIds),
+			},
+			hostStaticBulkHostsKey: {
+				Description: "The hosts as a map of their name to their address. The address is an IP address or " +
+					"a hostname, without port.",
+				Type:             schema.TypeMap,
+				Required:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateHostStaticBulkHosts,
+			},
+			hostStaticBulkHostSetIdKey: {
+				Description: "The ID of a static host set of the catalog the hosts are added to. Hosts of the set " +
+					"that are not managed by this resource are not removed.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateId(staticHostSetIds),
+			},
+			hostStaticBulkHostIdsKey: {
+				Description: "The IDs of the hosts as a map of their name to their ID.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// validateHostStaticBulkHosts checks the address of every host, reporting all
+// the invalid ones at once.
+func validateHostStaticBulkHosts(i interface{}, path cty.Path) diag.Diagnostics {
+	hostsMap, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	var diags diag.Diagnostics
+	for _, name := range slices.Sorted(maps.Keys(hostsMap)) {
+		address, ok := hostsMap[name].(string)
+		if !ok {
+			continue
+		}
+		if err := checkHostAddress(address); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("invalid address for host %q", name),
+				Detail:        err.Error(),
+				AttributePath: path.IndexString(name),
+			})
+		}
+	}
+	return diags
+}
+
+// resourceHostStaticBulkCustomizeDiff leaves host_ids unknown when hosts are
+// added or removed. Hosts whose address changes are updated in place and keep
+// their ID.
+func resourceHostStaticBulkCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.HasChange(hostStaticBulkHostsKey) {
+		return nil
+	}
+	if !d.NewValueKnown(hostStaticBulkHostsKey) {
+		return d.SetNewComputed(hostStaticBulkHostIdsKey)
+	}
+	o, n := d.GetChange(hostStaticBulkHostsKey)
+	if !slices.Equal(slices.Sorted(maps.Keys(o.(map[string]interface{}))), slices.Sorted(maps.Keys(n.(map[string]interface{})))) {
+		return d.SetNewComputed(hostStaticBulkHostIdsKey)
+	}
+	return nil
+}
+
+// hostStaticBulkId returns a new ID for a bulk resource managing hosts of the
+// given catalog. The suffix is random so that the ID stays the same when hosts
+// are added or removed, and several bulk resources can share a catalog.
+func hostStaticBulkId(hostCatalogId string) (string, error) {
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", err
+	}
+	return attachmentId(hostCatalogId, hex.EncodeToString(suffix)), nil
+}
+
+// hostStaticBulkAddress returns the address of a static host.
+func hostStaticBulkAddress(h *hosts.Host) string {
+	address, _ := h.Attributes["address"].(string)
+	return address
+}
+
+// listHostStaticBulkHosts lists the hosts of a catalog with the given IDs,
+// keyed by ID.
+func listHostStaticBulkHosts(ctx context.Context, client *api.Client, hostCatalogId string, ids map[string]interface{}) (map[string]*hosts.Host, error) {
+	hl, err := hosts.NewClient(client).List(ctx, hostCatalogId)
+	if err != nil {
+		return nil, err
+	}
+	tracked := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		tracked[id.(string)] = true
+	}
+	found := make(map[string]*hosts.Host, len(ids))
+	for _, h := range hl.GetItems() {
+		if tracked[h.Id] {
+			found[h.Id] = h
+		}
+	}
+	return found, nil
+}
+
+func resourceHostStaticBulkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	found, err := listHostStaticBulkHosts(ctx, md.client, d.Get(HostCatalogIdKey).(string), d.Get(hostStaticBulkHostIdsKey).(map[string]interface{}))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error listing hosts: %v", err)
+	}
+
+	// Hosts deleted outside of Terraform are dropped to be created again, and
+	// hosts renamed outside of Terraform show up under their new name.
+	hostsMap := make(map[string]interface{}, len(found))
+	ids := make(map[string]interface{}, len(found))
+	for id, h := range found {
+		hostsMap[h.Name] = hostStaticBulkAddress(h)
+		ids[h.Name] = id
+	}
+	if err := d.Set(hostStaticBulkHostsKey, hostsMap); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(hostStaticBulkHostIdsKey, ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The host set is unset when some hosts are missing from it so that the
+	// next apply adds them again.
+	if hostSetId := d.Get(hostStaticBulkHostSetIdKey).(string); hostSetId != "" {
+		hsrr, err := hostsets.NewClient(md.client).Read(ctx, hostSetId)
+		if err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+				return diag.FromErr(d.Set(hostStaticBulkHostSetIdKey, ""))
+			}
+			return diag.Errorf("error reading host set: %v", err)
+		}
+		for id := range found {
+			if !slices.Contains(hsrr.Item.HostIds, id) {
+				return diag.FromErr(d.Set(hostStaticBulkHostSetIdKey, ""))
+			}
+		}
+	}
+	return nil
+}
+
+func resourceHostStaticBulkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	id, err := hostStaticBulkId(d.Get(HostCatalogIdKey).(string))
+	if err != nil {
+		return diag.Errorf("error generating ID: %v", err)
+	}
+	d.SetId(id)
+	if diags := applyHostStaticBulkHosts(ctx, d, md.client, map[string]interface{}{}, map[string]interface{}{}); diags.HasError() {
+		return diags
+	}
+	if err := syncHostStaticBulkHostSet(ctx, d, md.client); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceHostStaticBulkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+
+	oldHosts, _ := d.GetChange(hostStaticBulkHostsKey)
+	oldIds, _ := d.GetChange(hostStaticBulkHostIdsKey)
+	if diags := applyHostStaticBulkHosts(ctx, d, md.client, oldHosts.(map[string]interface{}), oldIds.(map[string]interface{})); diags.HasError() {
+		return diags
+	}
+	if err := syncHostStaticBulkHostSet(ctx, d, md.client); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// applyHostStaticBulkHosts deletes, updates and creates the hosts that changed
+// between the old hosts and the configured ones. Hosts are deleted first since
+// their names must be unique in the catalog. host_ids is set even when an
+// operation fails so that the hosts already created are tracked.
+func applyHostStaticBulkHosts(ctx context.Context, d *schema.ResourceData, client *api.Client, oldHosts, oldIds map[string]interface{}) (diags diag.Diagnostics) {
+	hc := hosts.NewClient(client)
+	newHosts := d.Get(hostStaticBulkHostsKey).(map[string]interface{})
+
+	ids := maps.Clone(oldIds)
+	defer func() {
+		if err := d.Set(hostStaticBulkHostIdsKey, ids); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}()
+
+	for _, name := range slices.Sorted(maps.Keys(oldIds)) {
+		if _, ok := newHosts[name]; ok {
+			continue
+		}
+		id := oldIds[name].(string)
+		if _, err := hc.Delete(ctx, id); err != nil {
+			if apiErr := api.AsServerError(err); apiErr == nil || apiErr.Response().StatusCode() != http.StatusNotFound {
+				return diag.Errorf("error deleting host %q: %v", name, err)
+			}
+		}
+		delete(ids, name)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(newHosts)) {
+		address := newHosts[name].(string)
+		if id, ok := ids[name]; ok {
+			if oldHosts[name] == address {
+				continue
+			}
+			_, err := hc.Update(ctx, id.(string), 0, hosts.WithStaticHostAddress(address), hosts.WithAutomaticVersioning(true))
+			if err != nil {
+				return diag.Errorf("error updating host %q: %v", name, err)
+			}
+			continue
+		}
+
+		hcr, err := hc.Create(ctx, d.Get(HostCatalogIdKey).(string), hosts.WithName(name), hosts.WithStaticHostAddress(address))
+		if err != nil {
+			return diag.Errorf("error creating host %q: %v", name, err)
+		}
+		if hcr == nil {
+			return diag.Errorf("nil host %q after create", name)
+		}
+		ids[name] = hcr.Item.Id
+	}
+	return nil
+}
+
+// syncHostStaticBulkHostSet adds the hosts to the configured host set, and
+// removes them from the previous one when it changes.
+func syncHostStaticBulkHostSet(ctx context.Context, d *schema.ResourceData, client *api.Client) error {
+	hsc := hostsets.NewClient(client)
+	ids := d.Get(hostStaticBulkHostIdsKey).(map[string]interface{})
+
+	// members returns the IDs of the hosts of the resource that are, or are
+	// not, in the host set.
+	members := func(hostSetId string, in bool) ([]string, error) {
+		hsrr, err := hsc.Read(ctx, hostSetId)
+		if err != nil {
+			return nil, err
+		}
+		var out []string
+		for _, id := range ids {
+			if slices.Contains(hsrr.Item.HostIds, id.(string)) == in {
+				out = append(out, id.(string))
+			}
+		}
+		slices.Sort(out)
+		return out, nil
+	}
+
+	o, n := d.GetChange(hostStaticBulkHostSetIdKey)
+	if oldHostSetId := o.(string); oldHostSetId != "" && oldHostSetId != n.(string) {
+		remove, err := members(oldHostSetId, true)
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			remove, err = nil, nil
+		}
+		if err != nil {
+			return fmt.Errorf("error reading host set %q: %w", oldHostSetId, err)
+		}
+		if len(remove) > 0 {
+			if _, err := hsc.RemoveHosts(ctx, oldHostSetId, 0, remove, hostsets.WithAutomaticVersioning(true)); err != nil {
+				return fmt.Errorf("error removing hosts from host set %q: %w", oldHostSetId, err)
+			}
+		}
+	}
+
+	hostSetId := n.(string)
+	if hostSetId == "" {
+		return nil
+	}
+	add, err := members(hostSetId, false)
+	if err != nil {
+		return fmt.Errorf("error reading host set %q: %w", hostSetId, err)
+	}
+	if len(add) > 0 {
+		if _, err := hsc.AddHosts(ctx, hostSetId, 0, add, hostsets.WithAutomaticVersioning(true)); err != nil {
+			return fmt.Errorf("error adding hosts to host set %q: %w", hostSetId, err)
+		}
+	}
+	return nil
+}
+
+func resourceHostStaticBulkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	hc := hosts.NewClient(md.client)
+
+	ids := d.Get(hostStaticBulkHostIdsKey).(map[string]interface{})
+	for _, name := range slices.Sorted(maps.Keys(ids)) {
+		if _, err := hc.Delete(ctx, ids[name].(string)); err != nil {
+			if apiErr := api.AsServerError(err); apiErr == nil || apiErr.Response().StatusCode() != http.StatusNotFound {
+				return diag.Errorf("error deleting host %q: %v", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// resourceHostStaticBulkImport imports the hosts of a host catalog with the
+// given names, the ID being in the form <host_catalog_id>:<name>,<name>. The
+// hosts to import are listed explicitly since the other hosts of the catalog
+// may be managed by other resources, and would be deleted by the next apply.
+func resourceHostStaticBulkImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	md := meta.(*metaData)
+
+	parts, err := splitAttachmentId(d.Id(), 2)
+	if err != nil {
+		return nil, fmt.Errorf("%w, expected <host_catalog_id>:<name>,<name>,... with the names of the hosts to import", err)
+	}
+	hostCatalogId, names := parts[0], strings.Split(parts[1], ",")
+
+	hl, err := hosts.NewClient(md.client).List(ctx, hostCatalogId)
+	if err != nil {
+		return nil, fmt.Errorf("error listing hosts: %w", err)
+	}
+	byName := map[string]string{}
+	for _, h := range hl.GetItems() {
+		if h.Name != "" {
+			byName[h.Name] = h.Id
+		}
+	}
+	ids := map[string]interface{}{}
+	for _, name := range names {
+		id, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("no host named %q in host catalog %q", name, hostCatalogId)
+		}
+		ids[name] = id
+	}
+
+	id, err := hostStaticBulkId(hostCatalogId)
+	if err != nil {
+		return nil, fmt.Errorf("error generating ID: %w", err)
+	}
+	d.SetId(id)
+	if err := d.Set(HostCatalogIdKey, hostCatalogId); err != nil {
+		return nil, err
+	}
+	if err := d.Set(hostStaticBulkHostIdsKey, ids); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
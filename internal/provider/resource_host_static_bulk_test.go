@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const hostStaticBulkConfig = `
+resource "boundary_host_catalog_static" "foo" {
+	name       = "test"
+	scope_id   = boundary_scope.proj1.id
+	depends_on = [boundary_role.proj1_admin]
+}
+
+resource "boundary_host_set_static" "foo" {
+	name            = "test"
+	host_catalog_id = boundary_host_catalog_static.foo.id
+	lifecycle {
+		ignore_changes = [host_ids]
+	}
+}
+
+resource "boundary_host_static_bulk" "foo" {
+	host_catalog_id = boundary_host_catalog_static.foo.id
+	%s
+	hosts = {
+		%s
+	}
+}`
+
+func TestAccHostStaticBulk(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+	url := tc.ApiAddrs()[0]
+
+	const name = "boundary_host_static_bulk.foo"
+	const hostSet = "host_set_id = boundary_host_set_static.foo.id"
+
+	var created map[string]string
+	var id string
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckHostStaticBulkDestroy(provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(hostStaticBulkConfig, hostSet, `
+		web-1 = "10.0.0.1"
+		web-2 = "web-2.example.com"`)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostStaticBulkId(name, &id),
+					resource.TestCheckResourceAttr(name, "host_ids.%", "2"),
+					testAccCheckHostStaticBulkHosts(provider, name, map[string]string{
+						"web-1": "10.0.0.1",
+						"web-2": "web-2.example.com",
+					}, &created),
+					testAccCheckHostStaticBulkHostSet(provider, name, "boundary_host_set_static.foo", true),
+				),
+			},
+			// the host set is not imported, and the imported resource gets a
+			// new ID so it is compared by host catalog and hosts
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateIdFunc: importStateIdHostStaticBulk(name),
+				ImportStateCheck:  testAccCheckHostStaticBulkImport(&created),
+			},
+			{
+				// the hosts to import must be listed
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateIdFunc: importStateIdFromResource("boundary_host_catalog_static.foo"),
+				ExpectError:       regexp.MustCompile(`expected <host_catalog_id>:<name>,<name>`),
+			},
+			{
+				// web-1 is updated in place, web-2 is deleted and web-3 created
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(hostStaticBulkConfig, hostSet, `
+		web-1 = "10.0.0.11"
+		web-3 = "10.0.0.3"`)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostStaticBulkId(name, &id),
+					resource.TestCheckResourceAttr(name, "host_ids.%", "2"),
+					testAccCheckHostStaticBulkHosts(provider, name, map[string]string{
+						"web-1": "10.0.0.11",
+						"web-3": "10.0.0.3",
+					}, nil),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources[name].Primary.Attributes
+						if attrs["host_ids.web-1"] != created["web-1"] {
+							return fmt.Errorf("web-1 was recreated as %q, expected %q", attrs["host_ids.web-1"], created["web-1"])
+						}
+						return nil
+					},
+					testAccCheckHostsDeleted(provider, func() []string { return []string{created["web-2"]} }),
+					testAccCheckHostStaticBulkHostSet(provider, name, "boundary_host_set_static.foo", true),
+				),
+			},
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(hostStaticBulkConfig, hostSet, `
+		web-1 = "10.0.0.1:22"
+		web-4 = "10.0.0.256"`)),
+				ExpectError: regexp.MustCompile(`invalid address for host "web-1"(.|\n)*invalid address for host "web-4"`),
+			},
+			{
+				// removing the host set removes the hosts from it
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(hostStaticBulkConfig, "", `
+		web-1 = "10.0.0.11"
+		web-3 = "10.0.0.3"`)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, hostStaticBulkHostSetIdKey, ""),
+					testAccCheckHostStaticBulkHostSet(provider, name, "boundary_host_set_static.foo", false),
+				),
+			},
+		},
+	})
+}
+
+// hostStaticBulkNames returns the names of the hosts of a bulk resource.
+func hostStaticBulkNames(rs *terraform.ResourceState) []string {
+	var names []string
+	for key := range rs.Primary.Attributes {
+		if name, ok := strings.CutPrefix(key, hostStaticBulkHostIdsKey+"."); ok && name != "%" {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// testAccCheckHostStaticBulkId checks that the ID of a bulk resource starts
+// with its host catalog ID, and that it does not change once stored in id.
+func testAccCheckHostStaticBulkId(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		if !strings.HasPrefix(rs.Primary.ID, rs.Primary.Attributes[HostCatalogIdKey]+":") {
+			return fmt.Errorf("ID %q does not start with the host catalog ID", rs.Primary.ID)
+		}
+		if *id == "" {
+			*id = rs.Primary.ID
+		} else if rs.Primary.ID != *id {
+			return fmt.Errorf("ID changed from %q to %q", *id, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+// testAccCheckHostStaticBulkImport checks that an imported bulk resource
+// tracks the hosts with the given IDs.
+func testAccCheckHostStaticBulkImport(ids *map[string]string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected 1 imported resource, got %d", len(states))
+		}
+		attrs := states[0].Attributes
+		if attrs[hostStaticBulkHostIdsKey+".%"] != strconv.Itoa(len(*ids)) {
+			return fmt.Errorf("got %s imported hosts, expected %d", attrs[hostStaticBulkHostIdsKey+".%"], len(*ids))
+		}
+		for name, id := range *ids {
+			if got := attrs[hostStaticBulkHostIdsKey+"."+name]; got != id {
+				return fmt.Errorf("host %q imported as %q, expected %q", name, got, id)
+			}
+		}
+		if !strings.HasPrefix(states[0].ID, attrs[HostCatalogIdKey]+":") {
+			return fmt.Errorf("ID %q does not start with the host catalog ID", states[0].ID)
+		}
+		return nil
+	}
+}
+
+// importStateIdHostStaticBulk returns the import ID of a bulk resource, which
+// lists the names of its hosts.
+func importStateIdHostStaticBulk(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", name)
+		}
+		return attachmentId(rs.Primary.Attributes[HostCatalogIdKey], strings.Join(hostStaticBulkNames(rs), ",")), nil
+	}
+}
+
+// testAccCheckHostStaticBulkHosts checks that the hosts of a bulk resource
+// exist with the given addresses, and stores their IDs by name.
+func testAccCheckHostStaticBulkHosts(testProvider *schema.Provider, name string, want map[string]string, ids *map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		md := testProvider.Meta().(*metaData)
+		hc := hosts.NewClient(md.client)
+
+		got := map[string]string{}
+		for hostName, address := range want {
+			id := rs.Primary.Attributes[hostStaticBulkHostIdsKey+"."+hostName]
+			if id == "" {
+				return fmt.Errorf("no ID for host %q", hostName)
+			}
+			hr, err := hc.Read(context.Background(), id)
+			if err != nil {
+				return fmt.Errorf("Got an error when reading host %q: %v", id, err)
+			}
+			if hr.Item.Name != hostName {
+				return fmt.Errorf("host %q is named %q, expected %q", id, hr.Item.Name, hostName)
+			}
+			if got := hr.Item.Attributes["address"]; got != address {
+				return fmt.Errorf("host %q has the address %q, expected %q", hostName, got, address)
+			}
+			got[hostName] = id
+		}
+		if ids != nil {
+			*ids = got
+		}
+		return nil
+	}
+}
+
+// testAccCheckHostStaticBulkHostSet checks whether the hosts of a bulk resource
+// are members of a host set.
+func testAccCheckHostStaticBulkHostSet(testProvider *schema.Provider, name, hostSetName string, members bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		hs, ok := s.RootModule().Resources[hostSetName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", hostSetName)
+		}
+
+		md := testProvider.Meta().(*metaData)
+		hsrr, err := hostsets.NewClient(md.client).Read(context.Background(), hs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Got an error when reading host set %q: %v", hs.Primary.ID, err)
+		}
+		for key, id := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, hostStaticBulkHostIdsKey+".") || key == hostStaticBulkHostIdsKey+".%" {
+				continue
+			}
+			if slices.Contains(hsrr.Item.HostIds, id) != members {
+				return fmt.Errorf("host %q: membership of host set %q is not %t", id, hs.Primary.ID, members)
+			}
+		}
+		return nil
+	}
+}
+
+// testAccCheckHostsDeleted checks that the hosts with the given IDs do not
+// exist anymore.
+func testAccCheckHostsDeleted(testProvider *schema.Provider, ids func() []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		md := testProvider.Meta().(*metaData)
+		hc := hosts.NewClient(md.client)
+
+		for _, id := range ids() {
+			_, err := hc.Read(context.Background(), id)
+			if apiErr := api.AsServerError(err); apiErr == nil || apiErr.Response().StatusCode() != http.StatusNotFound {
+				return fmt.Errorf("didn't get a 404 when reading deleted host %q: %v", id, err)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckHostStaticBulkDestroy(testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "boundary_host_static_bulk" {
+				continue
+			}
+			var ids []string
+			for key, id := range rs.Primary.Attributes {
+				if strings.HasPrefix(key, hostStaticBulkHostIdsKey+".") && key != hostStaticBulkHostIdsKey+".%" {
+					ids = append(ids, id)
+				}
+			}
+			if err := testAccCheckHostsDeleted(testProvider, func() []string { return ids })(s); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func TestHostStaticBulkId(t *testing.T) {
+	id, err := hostStaticBulkId("hcst_1234567890")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts, err := splitAttachmentId(id, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parts[0] != "hcst_1234567890" || parts[1] == "" {
+		t.Errorf("ID %q is not the host catalog ID followed by a suffix", id)
+	}
+	other, err := hostStaticBulkId("hcst_1234567890")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == id {
+		t.Errorf("bulk resources of the same catalog have the same ID %q", id)
+	}
+}
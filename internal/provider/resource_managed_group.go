@@ -46,15 +46,17 @@ func resourceManagedGroup() *schema.Resource {
 				Optional:    true,
 			},
 			AuthMethodIdKey: {
-				Description: "The resource ID for the auth method.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The resource ID for the auth method.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(oidcAuthMethodIds),
 			},
 			managedGroupFilterKey: {
-				Description: "Boolean expression to filter the workers for this managed group.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:      "Boolean expression to filter the workers for this managed group.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateFilter,
 			},
 		},
 	}
@@ -44,10 +44,11 @@ func resourceManagedGroupLdap() *schema.Resource {
 				Optional:    true,
 			},
 			AuthMethodIdKey: {
-				Description: "The resource ID for the auth method.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The resource ID for the auth method.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(ldapAuthMethodIds),
 			},
 			managedGroupLdapGroupNamesKey: {
 				Description: "The list of groups that make up the managed group.",
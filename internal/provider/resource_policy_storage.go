@@ -53,9 +53,10 @@ func resourcePolicyStorage() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope for this policy.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:      "The scope for this policy.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateId(orgScopeIds...),
 			},
 			policyStorageRetainForDaysKey: {
 				Description:  "The number of days a session recording is required to be stored. Defaults to 0: allow deletions at any time. However, " + policyStorageRetainForDaysKey + " and " + policyStorageDeleteAfterDaysKey + " cannot both be 0.",
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"unicode"
 
@@ -16,12 +17,22 @@ import (
 	"github.com/hashicorp/boundary/api/roles"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
 	roleGrantScopeIdsKey = "grant_scope_ids"
 	rolePrincipalIdsKey  = "principal_ids"
 	roleGrantStringsKey  = "grant_strings"
+
+	roleRewriteDeprecatedGrantsKey = "rewrite_deprecated_grants"
+	roleRewrittenGrantsKey         = "rewritten_grants"
+
+	roleGrantKey             = "grant"
+	roleGrantIdsKey          = "ids"
+	roleGrantTypeKey         = "type"
+	roleGrantActionsKey      = "actions"
+	roleGrantOutputFieldsKey = "output_fields"
 )
 
 func resourceRole() *schema.Resource {
@@ -33,7 +44,16 @@ func resourceRole() *schema.Resource {
 		UpdateContext: resourceRoleUpdate,
 		DeleteContext: resourceRoleDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceRoleImport,
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceRoleV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceRoleStateUpgradeV0,
+				Version: 0,
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -53,32 +73,147 @@ func resourceRole() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(anyScopeIds...),
 			},
 			rolePrincipalIdsKey: {
-				Description: "A list of principal (user or group) IDs to add as principals on the role.",
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A list of principal (user or group) IDs to add as principals on the role. Updates only add " +
+					"or remove the principals that changed. Principals added by `boundary_role_principal` show up as drift " +
+					"unless this attribute is in `ignore_changes`.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateId(principalIds...),
+				},
 			},
 			roleGrantStringsKey: {
-				Description: "A list of stringified grants for the role.",
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A list of stringified grants for the role. Grants are validated at plan time, and updates " +
+					"only add or remove the grants that changed. List this attribute in `ignore_changes` when grants are " +
+					"also added with `boundary_role_grant`.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      roleGrantStringHash,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateFunc:     validateGrantString,
+					DiffSuppressFunc: roleGrantStringDiffSuppress,
+				},
+			},
+			roleRewriteDeprecatedGrantsKey: {
+				Description: "When true, grants in `grant_strings` that use deprecated syntax, such as the `id` field or " +
+					"deprecated actions, are rewritten to their modern equivalent in Boundary and in the state on the next apply. " +
+					"The configuration can keep the deprecated form.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			roleRewrittenGrantsKey: {
+				Description: "The deprecated grants that were last rewritten because of `rewrite_deprecated_grants`, mapping each " +
+					"grant as configured to the grant sent to Boundary.",
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			roleGrantKey: {
+				Description: "A structured grant for the role. Each block is rendered to a canonical grant string, so the " +
+					"order of its components does not matter. Can be used alongside `grant_strings`.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						roleGrantIdsKey: {
+							Description: "The IDs of the resources the grant applies to, or `*` for all resources.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						roleGrantTypeKey: {
+							Description:  "The type of the resources the grant applies to, or `*` for all types.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(grantResourceTypeNames(), false),
+						},
+						roleGrantActionsKey: {
+							Description: "The actions granted.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						roleGrantOutputFieldsKey: {
+							Description: "The fields returned for the resources the grant applies to.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
 			},
 			roleGrantScopeIdsKey: {
-				Description: `A list of scopes for which the grants in this role should apply, which can include the special values "this", "children", or "descendants"`,
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Computed:    true,
+				Description: `A list of scopes for which the grants in this role should apply, which can include the special values "this", "children", or "descendants". ` +
+					"If omitted, grant scopes are not managed by this resource, which allows them to be managed with `boundary_role_grant_scope`.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateGrantScopeId,
+				},
+				Computed: true,
 			},
 		},
+
+		CustomizeDiff: resourceRoleCustomizeDiff,
+	}
+}
+
+func resourceRoleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(roleGrantKey) || !d.NewValueKnown(roleGrantStringsKey) {
+		return nil
+	}
+
+	// Show the deprecated grants that are about to be rewritten in the plan.
+	// Grants are considered rewritten once their modern form is in the state.
+	if d.Get(roleRewriteDeprecatedGrantsKey).(bool) {
+		o, _ := d.GetChange(roleGrantStringsKey)
+		oldGrants := setToStrings(o)
+		rewrites := map[string]interface{}{}
+		for _, grant := range setToStrings(d.Get(roleGrantStringsKey)) {
+			modern, err := modernizeGrant(grant)
+			if err != nil {
+				return err
+			}
+			if modern != grant && !slices.Contains(oldGrants, modern) {
+				rewrites[grant] = modern
+			}
+		}
+		if len(rewrites) > 0 {
+			if err := d.SetNew(roleRewrittenGrantsKey, rewrites); err != nil {
+				return err
+			}
+		}
+	}
+	grantBlocks := roleGrantBlockStrings(d.Get(roleGrantKey).(*schema.Set))
+	for _, grant := range grantBlocks {
+		if _, err := validateGrant(grant); err != nil {
+			return fmt.Errorf("%s block: %w", roleGrantKey, err)
+		}
 	}
+
+	// Only denied grants can be reported here, warnings are emitted when the
+	// role is created or updated.
+	md, ok := meta.(*metaData)
+	if !ok || md.grantPolicy == nil || !d.NewValueKnown(ScopeIdKey) {
+		return nil
+	}
+	grants := append(setToStrings(d.Get(roleGrantStringsKey)), grantBlocks...)
+	var grantScopeIds []string
+	if d.NewValueKnown(roleGrantScopeIdsKey) {
+		grantScopeIds = setToStrings(d.Get(roleGrantScopeIdsKey))
+	}
+	return grantPolicyError(md.grantPolicy.check(d.Get(ScopeIdKey).(string), grants, grantScopeIds))
 }
 
 func setFromRoleResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
@@ -94,7 +229,11 @@ func setFromRoleResponseMap(d *schema.ResourceData, raw map[string]interface{})
 	if err := d.Set(rolePrincipalIdsKey, raw["principal_ids"]); err != nil {
 		return err
 	}
-	if err := d.Set(roleGrantStringsKey, raw["grant_strings"]); err != nil {
+	grantStrings, grantBlocks := splitRoleGrants(d, raw)
+	if err := d.Set(roleGrantStringsKey, grantStrings); err != nil {
+		return err
+	}
+	if err := d.Set(roleGrantKey, grantBlocks); err != nil {
 		return err
 	}
 	if err := d.Set(roleGrantScopeIdsKey, raw["grant_scope_ids"]); err != nil {
@@ -147,23 +286,11 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
-	var grantStrings []string
-	if grantStringsVal, ok := d.GetOk(roleGrantStringsKey); ok {
-		list := grantStringsVal.(*schema.Set).List()
-		grantStrings = make([]string, 0, len(list))
-		for _, i := range list {
-			for _, grant := range list {
-				deprecationNotice, err := checkGrantForDeprecation(grant.(string))
-				if err != nil {
-					return diag.FromErr(err)
-				}
-				if deprecationNotice != "" {
-					diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: "deprecated field found in grant", Detail: deprecationNotice})
-				}
-				grantStrings = append(grantStrings, grant.(string))
-			}
-			grantStrings = append(grantStrings, i.(string))
-		}
+	grantStrings, grantDiags := roleGrantsFromConfig(d)
+	diags = append(diags, grantDiags...)
+	diags = append(diags, md.grantPolicy.check(scopeId, grantStrings, grantScopeIds)...)
+	if diags.HasError() {
+		return diags
 	}
 
 	rc := roles.NewClient(md.client)
@@ -248,6 +375,18 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	md := meta.(*metaData)
 	rc := roles.NewClient(md.client)
 
+	var diags diag.Diagnostics
+	var grantStrings []string
+	if d.HasChanges(roleGrantStringsKey, roleGrantKey, roleGrantScopeIdsKey, roleRewrittenGrantsKey) {
+		var grantDiags diag.Diagnostics
+		grantStrings, grantDiags = roleGrantsFromConfig(d)
+		diags = append(diags, grantDiags...)
+		diags = append(diags, md.grantPolicy.check(d.Get(ScopeIdKey).(string), grantStrings, setToStrings(d.Get(roleGrantScopeIdsKey)))...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	opts := []roles.Option{}
 
 	var name *string
@@ -291,26 +430,31 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
-	var diags diag.Diagnostics
-	if d.HasChange(roleGrantStringsKey) {
-		var grantStrings []string
-		if grantStringsVal, ok := d.GetOk(roleGrantStringsKey); ok {
-			grants := grantStringsVal.(*schema.Set).List()
-			for _, grant := range grants {
-				deprecationNotice, err := checkGrantForDeprecation(grant.(string))
-				if err != nil {
-					return diag.FromErr(err)
-				}
-				if deprecationNotice != "" {
-					diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: "deprecated field found in grant", Detail: deprecationNotice})
-				}
-				grantStrings = append(grantStrings, grant.(string))
-			}
+	// Grants, principals and grant scopes may also be attached to the role by
+	// boundary_role_grant, boundary_role_principal and
+	// boundary_role_grant_scope, so only the values that changed are removed
+	// and added instead of replacing the full set.
+	if d.HasChanges(roleGrantStringsKey, roleGrantKey, roleRewrittenGrantsKey, rolePrincipalIdsKey, roleGrantScopeIdsKey) {
+		resourceMutexKV.Lock(d.Id())
+		defer resourceMutexKV.Unlock(d.Id())
+	}
+
+	if d.HasChanges(roleGrantStringsKey, roleGrantKey, roleRewrittenGrantsKey) {
+		removed, added := roleGrantChanges(d, grantStrings)
+		var result *roles.RoleUpdateResult
+		var err error
+		if len(removed) > 0 {
+			result, err = rc.RemoveGrants(ctx, d.Id(), 0, removed, roles.WithAutomaticVersioning(true))
 		}
-		_, err := rc.SetGrants(ctx, d.Id(), 0, grantStrings, roles.WithAutomaticVersioning(true))
-		if err != nil {
+		if err == nil && len(added) > 0 {
+			result, err = rc.AddGrants(ctx, d.Id(), 0, added, roles.WithAutomaticVersioning(true))
+		}
+		switch {
+		case err != nil:
 			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: "error setting grants", Detail: err.Error()})
-		} else {
+		case result != nil && d.Get(roleRewriteDeprecatedGrantsKey).(bool):
+			// store the rewritten grants rather than the configured ones
+			grantStrings, _ := splitRoleGrants(d, result.GetResponse().Map)
 			if err := d.Set(roleGrantStringsKey, grantStrings); err != nil {
 				return diag.FromErr(err)
 			}
@@ -318,44 +462,57 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	if d.HasChange(rolePrincipalIdsKey) {
-		var principalIds []string
-		if principalIdsVal, ok := d.GetOk(rolePrincipalIdsKey); ok {
-			principals := principalIdsVal.(*schema.Set).List()
-			for _, principal := range principals {
-				principalIds = append(principalIds, principal.(string))
-			}
+		removed, added := setChanges(d, rolePrincipalIdsKey)
+		var err error
+		if len(removed) > 0 {
+			_, err = rc.RemovePrincipals(ctx, d.Id(), 0, removed, roles.WithAutomaticVersioning(true))
+		}
+		if err == nil && len(added) > 0 {
+			_, err = rc.AddPrincipals(ctx, d.Id(), 0, added, roles.WithAutomaticVersioning(true))
 		}
-		_, err := rc.SetPrincipals(ctx, d.Id(), 0, principalIds, roles.WithAutomaticVersioning(true))
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: "error setting principals", Detail: err.Error()})
-		} else {
-			if err := d.Set(rolePrincipalIdsKey, principalIds); err != nil {
-				return diag.FromErr(err)
-			}
 		}
 	}
 
 	if d.HasChange(roleGrantScopeIdsKey) {
-		var grantScopeIds []string
-		if grantScopeIdsVal, ok := d.GetOk(roleGrantScopeIdsKey); ok {
-			grantScopes := grantScopeIdsVal.(*schema.Set).List()
-			for _, grantScope := range grantScopes {
-				grantScopeIds = append(grantScopeIds, grantScope.(string))
-			}
+		removed, added := setChanges(d, roleGrantScopeIdsKey)
+		var err error
+		if len(removed) > 0 {
+			_, err = rc.RemoveGrantScopes(ctx, d.Id(), 0, removed, roles.WithAutomaticVersioning(true))
+		}
+		if err == nil && len(added) > 0 {
+			_, err = rc.AddGrantScopes(ctx, d.Id(), 0, added, roles.WithAutomaticVersioning(true))
 		}
-		_, err := rc.SetGrantScopes(ctx, d.Id(), 0, grantScopeIds, roles.WithAutomaticVersioning(true))
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: "error setting grant scopes", Detail: err.Error()})
-		} else {
-			if err := d.Set(roleGrantScopeIdsKey, grantScopeIds); err != nil {
-				return diag.FromErr(err)
-			}
 		}
 	}
 
 	return diags
 }
 
+// roleGrantChanges returns the grants removed from and added to the role,
+// comparing grants with the ones of the prior state. Grants are compared as
+// strings, so a grant rewritten to another form is removed and added again,
+// which Boundary resolves since it compares grants in their canonical form.
+func roleGrantChanges(d *schema.ResourceData, grants []string) (removed, added []string) {
+	oldStrings, _ := d.GetChange(roleGrantStringsKey)
+	oldBlocks, _ := d.GetChange(roleGrantKey)
+	oldGrants := append(setToStrings(oldStrings), roleGrantBlockStrings(oldBlocks.(*schema.Set))...)
+	for _, grant := range oldGrants {
+		if !slices.Contains(grants, grant) && !slices.Contains(removed, grant) {
+			removed = append(removed, grant)
+		}
+	}
+	for _, grant := range grants {
+		if !slices.Contains(oldGrants, grant) {
+			added = append(added, grant)
+		}
+	}
+	return removed, added
+}
+
 func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
 	rc := roles.NewClient(md.client)
@@ -368,6 +525,145 @@ func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	return nil
 }
 
+func resourceRoleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set(roleRewriteDeprecatedGrantsKey, false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// roleGrantsFromConfig returns the grants configured on the role, combining
+// grant_strings with the strings rendered from the grant blocks. It returns nil
+// if neither is set.
+func roleGrantsFromConfig(d *schema.ResourceData) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var grantStrings []string
+	if grantStringsVal, ok := d.GetOk(roleGrantStringsKey); ok {
+		rewrite := d.Get(roleRewriteDeprecatedGrantsKey).(bool)
+		for _, grant := range setToStrings(grantStringsVal) {
+			if rewrite {
+				modern, err := modernizeGrant(grant)
+				if err != nil {
+					return nil, diag.FromErr(err)
+				}
+				grantStrings = append(grantStrings, modern)
+				continue
+			}
+			deprecationNotice, err := checkGrantForDeprecation(grant)
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			if deprecationNotice != "" {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "deprecated field found in grant",
+					Detail:   deprecationNotice + " Set `rewrite_deprecated_grants` to rewrite it automatically.",
+				})
+			}
+			grantStrings = append(grantStrings, grant)
+		}
+	}
+	if grantVal, ok := d.GetOk(roleGrantKey); ok {
+		for _, grant := range roleGrantBlockStrings(grantVal.(*schema.Set)) {
+			if !slices.Contains(grantStrings, grant) {
+				grantStrings = append(grantStrings, grant)
+			}
+		}
+	}
+	return grantStrings, diags
+}
+
+// roleGrantBlockStrings renders each grant block to its canonical grant string.
+func roleGrantBlockStrings(blocks *schema.Set) []string {
+	var grants []string
+	for _, b := range blocks.List() {
+		grants = append(grants, roleGrantBlockString(b.(map[string]interface{})))
+	}
+	return grants
+}
+
+func roleGrantBlockString(block map[string]interface{}) string {
+	return canonicalGrantString(
+		setToStrings(block[roleGrantIdsKey]),
+		block[roleGrantTypeKey].(string),
+		setToStrings(block[roleGrantActionsKey]),
+		setToStrings(block[roleGrantOutputFieldsKey]),
+	)
+}
+
+// roleGrantStringHash hashes grant strings by their modern form, so that a
+// deprecated grant and its rewritten equivalent are the same set element.
+func roleGrantStringHash(v interface{}) int {
+	grant := v.(string)
+	if modern, err := modernizeGrant(grant); err == nil {
+		grant = modern
+	}
+	return schema.HashString(grant)
+}
+
+// roleGrantStringDiffSuppress hides the difference between a deprecated grant
+// in the configuration and its rewritten equivalent in the state.
+func roleGrantStringDiffSuppress(_, old, new string, d *schema.ResourceData) bool {
+	if !d.Get(roleRewriteDeprecatedGrantsKey).(bool) || old == "" || new == "" {
+		return false
+	}
+	modern, err := modernizeGrant(new)
+	return err == nil && old == modern
+}
+
+// setToStrings converts the value of a set of strings to a slice.
+func setToStrings(v interface{}) []string {
+	set, ok := v.(*schema.Set)
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, set.Len())
+	for _, e := range set.List() {
+		out = append(out, e.(string))
+	}
+	return out
+}
+
+// splitRoleGrants splits the grants of a role response between grant_strings
+// and the grant blocks. A grant is assigned to a block when it matches the
+// canonical string of a grant block in the current state, everything else is
+// reported in grant_strings.
+func splitRoleGrants(d *schema.ResourceData, raw map[string]interface{}) ([]string, []interface{}) {
+	blocks := map[string]interface{}{}
+	if grantVal, ok := d.GetOk(roleGrantKey); ok {
+		for _, b := range grantVal.(*schema.Set).List() {
+			blocks[roleGrantBlockString(b.(map[string]interface{}))] = b
+		}
+	}
+	var knownGrantStrings []string
+	if grantStringsVal, ok := d.GetOk(roleGrantStringsKey); ok {
+		knownGrantStrings = setToStrings(grantStringsVal)
+	}
+
+	grantStrings := []string{}
+	grantBlocks := []interface{}{}
+	rawGrants, _ := raw["grants"].([]interface{})
+	for _, g := range rawGrants {
+		grant, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rawGrant, _ := grant["raw"].(string)
+		canonical, _ := grant["canonical"].(string)
+		block, ok := blocks[rawGrant]
+		if !ok {
+			block, ok = blocks[canonical]
+		}
+		if ok {
+			grantBlocks = append(grantBlocks, block)
+		}
+		if !ok || slices.Contains(knownGrantStrings, rawGrant) {
+			grantStrings = append(grantStrings, rawGrant)
+		}
+	}
+	return grantStrings, grantBlocks
+}
+
 func checkGrantForDeprecation(grantString string) (string, error) {
 	if len(grantString) == 0 {
 		return "", errors.New("missing grant string")
@@ -399,3 +695,46 @@ func checkGrantForDeprecation(grantString string) (string, error) {
 
 	return "", nil
 }
+
+// resourceRoleV0 is the schema of boundary_role before
+// rewrite_deprecated_grants was added. It is only used to decode old states.
+func resourceRoleV0() *schema.Resource {
+	stringSet := func() *schema.Schema {
+		return &schema.Schema{Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}}
+	}
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			IDKey:                {Type: schema.TypeString, Computed: true},
+			NameKey:              {Type: schema.TypeString, Optional: true},
+			DescriptionKey:       {Type: schema.TypeString, Optional: true},
+			ScopeIdKey:           {Type: schema.TypeString, Required: true},
+			rolePrincipalIdsKey:  stringSet(),
+			roleGrantStringsKey:  stringSet(),
+			roleGrantScopeIdsKey: {Type: schema.TypeSet, Optional: true, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			roleGrantKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						roleGrantIdsKey:          stringSet(),
+						roleGrantTypeKey:         {Type: schema.TypeString, Optional: true},
+						roleGrantActionsKey:      stringSet(),
+						roleGrantOutputFieldsKey: stringSet(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceRoleStateUpgradeV0 disables rewrite_deprecated_grants on existing
+// roles so that upgrading the provider does not produce a diff.
+func resourceRoleStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		rawState = map[string]interface{}{}
+	}
+	if _, ok := rawState[roleRewriteDeprecatedGrantsKey]; !ok {
+		rawState[roleRewriteDeprecatedGrantsKey] = false
+	}
+	return rawState, nil
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const roleGrantStringKey = "grant_string"
+
+func resourceRoleGrant() *schema.Resource {
+	return &schema.Resource{
+		Description: "The role grant resource allows you to add a single grant to a Boundary role " +
+			"without managing the full set of grants on that role. The `boundary_role` resource must omit " +
+			"`grant_strings` and list it in `ignore_changes`, otherwise both resources will fight over the grants.",
+
+		CreateContext: resourceRoleGrantCreate,
+		ReadContext:   resourceRoleGrantRead,
+		DeleteContext: resourceRoleGrantDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRoleGrantImport,
+		},
+		CustomizeDiff: resourceRoleGrantCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the role grant, in the form `<role_id>:<grant_string>`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			roleIdKey: {
+				Description:      "The ID of the role to add the grant to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(roleIds),
+			},
+			roleGrantStringKey: {
+				Description:  "The stringified grant to add to the role. The grant is validated at plan time.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateGrantString,
+			},
+		},
+	}
+}
+
+// resourceRoleGrantCustomizeDiff fails the plan when the grant policy of the
+// provider denies the grant.
+func resourceRoleGrantCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	md, ok := meta.(*metaData)
+	if !ok || md.grantPolicy == nil || !d.NewValueKnown(roleGrantStringKey) {
+		return nil
+	}
+	grant := d.Get(roleGrantStringKey).(string)
+	return grantPolicyError(md.grantPolicy.checkRoleAttachment(ctx, md.client, d.Get(roleIdKey).(string), []string{grant}, nil))
+}
+
+func resourceRoleGrantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	roleId := d.Get(roleIdKey).(string)
+	grant := d.Get(roleGrantStringKey).(string)
+
+	deprecationNotice, err := checkGrantForDeprecation(grant)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if deprecationNotice != "" {
+		diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: "deprecated field found in grant", Detail: deprecationNotice})
+	}
+	diags = append(diags, md.grantPolicy.checkRoleAttachment(ctx, md.client, roleId, []string{grant}, nil)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	resourceMutexKV.Lock(roleId)
+	defer resourceMutexKV.Unlock(roleId)
+
+	_, err = rc.AddGrants(ctx, roleId, 0, []string{grant}, roles.WithAutomaticVersioning(true))
+	if err != nil {
+		return append(diags, diag.Diagnostic{Severity: diag.Error, Summary: "error adding grant to role", Detail: err.Error()})
+	}
+
+	d.SetId(attachmentId(roleId, grant))
+
+	return diags
+}
+
+func resourceRoleGrantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	roleId := d.Get(roleIdKey).(string)
+	grant := d.Get(roleGrantStringKey).(string)
+
+	rr, err := rc.Read(ctx, roleId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			// the role is gone, destroy this resource
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading role: %v", err)
+	}
+	if rr == nil {
+		return diag.Errorf("role nil after read")
+	}
+
+	for _, g := range rr.GetItem().Grants {
+		if g.Raw == grant || g.Canonical == grant {
+			return nil
+		}
+	}
+
+	// this grant is no longer on the role, destroy this resource
+	d.SetId("")
+	return nil
+}
+
+func resourceRoleGrantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	roleId := d.Get(roleIdKey).(string)
+	grant := d.Get(roleGrantStringKey).(string)
+
+	resourceMutexKV.Lock(roleId)
+	defer resourceMutexKV.Unlock(roleId)
+
+	_, err := rc.RemoveGrants(ctx, roleId, 0, []string{grant}, roles.WithAutomaticVersioning(true))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error removing grant from role: %v", err)
+	}
+
+	return nil
+}
+
+func resourceRoleGrantImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitAttachmentId(d.Id(), 2)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set(roleIdKey, parts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set(roleGrantStringKey, parts[1]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
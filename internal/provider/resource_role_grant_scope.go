@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const roleGrantScopeIdKey = "grant_scope_id"
+
+func resourceRoleGrantScope() *schema.Resource {
+	return &schema.Resource{
+		Description: "The role grant scope resource allows you to add a single grant scope to a Boundary role " +
+			"without managing the full set of grant scopes on that role. The `boundary_role` resource must omit " +
+			"`grant_scope_ids`, otherwise both resources will fight over the grant scopes.",
+
+		CreateContext: resourceRoleGrantScopeCreate,
+		ReadContext:   resourceRoleGrantScopeRead,
+		DeleteContext: resourceRoleGrantScopeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRoleGrantScopeImport,
+		},
+		CustomizeDiff: resourceRoleGrantScopeCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the role grant scope, in the form `<role_id>:<grant_scope_id>`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			roleIdKey: {
+				Description:      "The ID of the role to add the grant scope to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(roleIds),
+			},
+			roleGrantScopeIdKey: {
+				Description:      `The scope for which the grants in the role should apply, which can be a scope ID or one of the special values "this", "children", or "descendants".`,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateGrantScopeId,
+			},
+		},
+	}
+}
+
+// resourceRoleGrantScopeCustomizeDiff fails the plan when the grant policy of
+// the provider denies the grant scope on the role. The role is read to get its
+// scope, so the check is left to Create when the role does not exist yet.
+func resourceRoleGrantScopeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	md, ok := meta.(*metaData)
+	if !ok || md.grantPolicy == nil || !d.NewValueKnown(roleIdKey) || !d.NewValueKnown(roleGrantScopeIdKey) {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChanges(roleIdKey, roleGrantScopeIdKey) {
+		return nil
+	}
+	diags := md.grantPolicy.checkRoleAttachment(ctx, md.client, d.Get(roleIdKey).(string), nil, []string{d.Get(roleGrantScopeIdKey).(string)})
+	return grantPolicyError(diags)
+}
+
+func resourceRoleGrantScopeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	roleId := d.Get(roleIdKey).(string)
+	grantScopeId := d.Get(roleGrantScopeIdKey).(string)
+
+	diags := md.grantPolicy.checkRoleAttachment(ctx, md.client, roleId, nil, []string{grantScopeId})
+	if diags.HasError() {
+		return diags
+	}
+
+	resourceMutexKV.Lock(roleId)
+	defer resourceMutexKV.Unlock(roleId)
+
+	_, err := rc.AddGrantScopes(ctx, roleId, 0, []string{grantScopeId}, roles.WithAutomaticVersioning(true))
+	if err != nil {
+		return append(diags, diag.Errorf("error adding grant scope to role: %v", err)...)
+	}
+
+	d.SetId(attachmentId(roleId, grantScopeId))
+
+	return diags
+}
+
+func resourceRoleGrantScopeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	roleId := d.Get(roleIdKey).(string)
+	grantScopeId := d.Get(roleGrantScopeIdKey).(string)
+
+	rr, err := rc.Read(ctx, roleId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			// the role is gone, destroy this resource
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading role: %v", err)
+	}
+	if rr == nil {
+		return diag.Errorf("role nil after read")
+	}
+
+	for _, id := range rr.GetItem().GrantScopeIds {
+		if id == grantScopeId {
+			return nil
+		}
+	}
+
+	// this grant scope is no longer on the role, destroy this resource
+	d.SetId("")
+	return nil
+}
+
+func resourceRoleGrantScopeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	roleId := d.Get(roleIdKey).(string)
+	grantScopeId := d.Get(roleGrantScopeIdKey).(string)
+
+	resourceMutexKV.Lock(roleId)
+	defer resourceMutexKV.Unlock(roleId)
+
+	_, err := rc.RemoveGrantScopes(ctx, roleId, 0, []string{grantScopeId}, roles.WithAutomaticVersioning(true))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error removing grant scope from role: %v", err)
+	}
+
+	return nil
+}
+
+func resourceRoleGrantScopeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitAttachmentId(d.Id(), 2)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set(roleIdKey, parts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set(roleGrantScopeIdKey, parts[1]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	orgRoleWithoutGrantScopes = `
+resource "boundary_role" "shared" {
+	name        = "shared"
+	description = "shared role"
+	scope_id    = boundary_scope.org1.id
+	depends_on  = [boundary_role.org1_admin]
+}`
+
+	roleGrantScopeProj = `
+resource "boundary_role_grant_scope" "proj" {
+	role_id        = boundary_role.shared.id
+	grant_scope_id = boundary_scope.proj1.id
+}`
+)
+
+func TestAccRoleGrantScope(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// test create, the role gets "this" as a default grant scope
+				Config: testConfig(url, fooOrg, firstProjectFoo, orgRoleWithoutGrantScopes, roleGrantScopeProj),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleResourceExists(provider, "boundary_role.shared"),
+					testAccCheckRoleResourceGrantScopesSet(provider, "boundary_role.shared", []string{"this", "boundary_scope.proj1"}),
+				),
+			},
+			importStep("boundary_role_grant_scope.proj"),
+			{
+				// remove the project grant scope
+				Config: testConfig(url, fooOrg, firstProjectFoo, orgRoleWithoutGrantScopes),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleResourceGrantScopesSet(provider, "boundary_role.shared", []string{"this"}),
+				),
+			},
+		},
+	})
+}
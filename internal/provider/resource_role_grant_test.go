@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	projRoleWithoutGrants = `
+resource "boundary_role" "shared" {
+	name        = "shared"
+	description = "shared role"
+	scope_id    = boundary_scope.proj1.id
+	depends_on  = [boundary_role.proj1_admin]
+
+	lifecycle {
+		ignore_changes = [grant_strings]
+	}
+}`
+
+	roleGrantReadonly = fmt.Sprintf(`
+resource "boundary_role_grant" "readonly" {
+	role_id      = boundary_role.shared.id
+	grant_string = "%s"
+}`, readonlyGrant)
+
+	roleGrantReadonlyUpdate = fmt.Sprintf(`
+resource "boundary_role_grant" "readonly_update" {
+	role_id      = boundary_role.shared.id
+	grant_string = "%s"
+}`, readonlyGrantUpdate)
+)
+
+func TestAccRoleGrant(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// test create
+				Config: testConfig(url, fooOrg, firstProjectFoo, projRoleWithoutGrants, roleGrantReadonly),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleGrantResourceExists(provider, "boundary_role_grant.readonly"),
+					testAccCheckRoleResourceGrantsSet(provider, "boundary_role.shared", []string{readonlyGrant}),
+				),
+			},
+			importStep("boundary_role_grant.readonly"),
+			{
+				// add a second grant, the first one must be left untouched
+				Config: testConfig(url, fooOrg, firstProjectFoo, projRoleWithoutGrants, roleGrantReadonly, roleGrantReadonlyUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleGrantResourceExists(provider, "boundary_role_grant.readonly"),
+					testAccCheckRoleGrantResourceExists(provider, "boundary_role_grant.readonly_update"),
+					testAccCheckRoleResourceGrantsSet(provider, "boundary_role.shared", []string{readonlyGrant, readonlyGrantUpdate}),
+				),
+			},
+			importStep("boundary_role_grant.readonly_update"),
+		},
+	})
+}
+
+func testAccCheckRoleGrantResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		parts, err := splitAttachmentId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		md := testProvider.Meta().(*metaData)
+		rolesClient := roles.NewClient(md.client)
+
+		rr, err := rolesClient.Read(context.Background(), parts[0])
+		if err != nil {
+			return fmt.Errorf("Got an error when reading role %q: %v", parts[0], err)
+		}
+
+		for _, grant := range rr.Item.Grants {
+			if grant.Raw == parts[1] {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("grant %q not found on role %q", parts[1], parts[0])
+	}
+}
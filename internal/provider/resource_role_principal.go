@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	roleIdKey          = "role_id"
+	rolePrincipalIdKey = "principal_id"
+)
+
+func resourceRolePrincipal() *schema.Resource {
+	return &schema.Resource{
+		Description: "The role principal resource allows you to add a single principal to a Boundary role " +
+			"without managing the full set of principals on that role. The `boundary_role` resource must omit " +
+			"`principal_ids` and list it in `ignore_changes`, otherwise both resources will fight over the principals.",
+
+		CreateContext: resourceRolePrincipalCreate,
+		ReadContext:   resourceRolePrincipalRead,
+		DeleteContext: resourceRolePrincipalDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRolePrincipalImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the role principal, in the form `<role_id>:<principal_id>`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			roleIdKey: {
+				Description:      "The ID of the role to add the principal to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(roleIds),
+			},
+			rolePrincipalIdKey: {
+				Description:      "The ID of the principal (user, group or managed group) to add to the role.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(principalIds...),
+			},
+		},
+	}
+}
+
+func resourceRolePrincipalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	roleId := d.Get(roleIdKey).(string)
+	principalId := d.Get(rolePrincipalIdKey).(string)
+
+	resourceMutexKV.Lock(roleId)
+	defer resourceMutexKV.Unlock(roleId)
+
+	_, err := rc.AddPrincipals(ctx, roleId, 0, []string{principalId}, roles.WithAutomaticVersioning(true))
+	if err != nil {
+		return diag.Errorf("error adding principal to role: %v", err)
+	}
+
+	d.SetId(attachmentId(roleId, principalId))
+
+	return nil
+}
+
+func resourceRolePrincipalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	roleId := d.Get(roleIdKey).(string)
+	principalId := d.Get(rolePrincipalIdKey).(string)
+
+	rr, err := rc.Read(ctx, roleId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			// the role is gone, destroy this resource
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading role: %v", err)
+	}
+	if rr == nil {
+		return diag.Errorf("role nil after read")
+	}
+
+	for _, id := range rr.GetItem().PrincipalIds {
+		if id == principalId {
+			return nil
+		}
+	}
+
+	// this principal is no longer on the role, destroy this resource
+	d.SetId("")
+	return nil
+}
+
+func resourceRolePrincipalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	rc := roles.NewClient(md.client)
+
+	roleId := d.Get(roleIdKey).(string)
+	principalId := d.Get(rolePrincipalIdKey).(string)
+
+	resourceMutexKV.Lock(roleId)
+	defer resourceMutexKV.Unlock(roleId)
+
+	_, err := rc.RemovePrincipals(ctx, roleId, 0, []string{principalId}, roles.WithAutomaticVersioning(true))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error removing principal from role: %v", err)
+	}
+
+	return nil
+}
+
+func resourceRolePrincipalImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitAttachmentId(d.Id(), 2)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set(roleIdKey, parts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set(rolePrincipalIdKey, parts[1]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
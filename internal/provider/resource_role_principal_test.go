@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	projRoleWithoutPrincipals = `
+resource "boundary_role" "shared" {
+	name        = "shared"
+	description = "shared role"
+	scope_id    = boundary_scope.proj1.id
+	depends_on  = [boundary_role.proj1_admin]
+
+	lifecycle {
+		ignore_changes = [principal_ids]
+	}
+}`
+
+	rolePrincipalFoo = `
+resource "boundary_role_principal" "foo" {
+	role_id      = boundary_role.shared.id
+	principal_id = boundary_user.foo.id
+}`
+
+	rolePrincipalBar = `
+resource "boundary_role_principal" "bar" {
+	role_id      = boundary_role.shared.id
+	principal_id = boundary_user.bar.id
+}`
+)
+
+func TestAccRolePrincipal(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// test create
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooUser, projRoleWithoutPrincipals, rolePrincipalFoo),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleResourceExists(provider, "boundary_role.shared"),
+					testAccCheckRolePrincipalResourceExists(provider, "boundary_role_principal.foo"),
+					testAccCheckRoleResourcePrincipalsSet(provider, "boundary_role.shared", []string{"boundary_user.foo"}),
+				),
+			},
+			importStep("boundary_role_principal.foo"),
+			{
+				// add a second principal, the first one must be left untouched
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooUser, barUser, projRoleWithoutPrincipals, rolePrincipalFoo, rolePrincipalBar),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePrincipalResourceExists(provider, "boundary_role_principal.foo"),
+					testAccCheckRolePrincipalResourceExists(provider, "boundary_role_principal.bar"),
+					testAccCheckRoleResourcePrincipalsSet(provider, "boundary_role.shared", []string{"boundary_user.foo", "boundary_user.bar"}),
+				),
+			},
+			importStep("boundary_role_principal.bar"),
+			{
+				// remove the first principal
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooUser, barUser, projRoleWithoutPrincipals, rolePrincipalBar),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePrincipalResourceExists(provider, "boundary_role_principal.bar"),
+					testAccCheckRoleResourcePrincipalsSet(provider, "boundary_role.shared", []string{"boundary_user.bar"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRolePrincipalResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		parts, err := splitAttachmentId(rs.Primary.ID, 2)
+		if err != nil {
+			return err
+		}
+
+		md := testProvider.Meta().(*metaData)
+		rolesClient := roles.NewClient(md.client)
+
+		rr, err := rolesClient.Read(context.Background(), parts[0])
+		if err != nil {
+			return fmt.Errorf("Got an error when reading role %q: %v", parts[0], err)
+		}
+
+		for _, principalId := range rr.Item.PrincipalIds {
+			if principalId == parts[1] {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("principal %q not found on role %q", parts[1], parts[0])
+	}
+}
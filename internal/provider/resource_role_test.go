@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"slices"
 	"strings"
 	"testing"
 
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -185,6 +188,74 @@ resource "boundary_role" "with_grants" {
 	scope_id      = boundary_scope.proj1.id
 	depends_on    = [boundary_role.proj1_admin]
 }`, readonlyGrant, readonlyGrantUpdate)
+
+	targetGrantCanonical = "ids=*;type=target;actions=authorize-session,read;output_fields=id,name"
+
+	projRoleWithGrantBlocks = fmt.Sprintf(`
+resource "boundary_role" "with_grants" {
+	name          = "with_grants"
+	description   = "with grants"
+	grant_strings = ["%s"]
+	scope_id      = boundary_scope.proj1.id
+	depends_on    = [boundary_role.proj1_admin]
+
+	grant {
+		ids           = ["*"]
+		type          = "target"
+		actions       = ["read", "authorize-session"]
+		output_fields = ["name", "id"]
+	}
+}`, readonlyGrant)
+
+	projRoleWithGrantBlocksReordered = fmt.Sprintf(`
+resource "boundary_role" "with_grants" {
+	name          = "with_grants"
+	description   = "with grants"
+	grant_strings = ["%s"]
+	scope_id      = boundary_scope.proj1.id
+	depends_on    = [boundary_role.proj1_admin]
+
+	grant {
+		actions       = ["authorize-session", "read"]
+		output_fields = ["id", "name"]
+		type          = "target"
+		ids           = ["*"]
+	}
+}`, readonlyGrant)
+
+	projRoleWithInvalidGrantBlock = `
+resource "boundary_role" "with_grants" {
+	name        = "with_grants"
+	description = "with grants"
+	scope_id    = boundary_scope.proj1.id
+	depends_on  = [boundary_role.proj1_admin]
+
+	grant {
+		ids     = ["*"]
+		type    = "host-set"
+		actions = ["authorize-session"]
+	}
+}`
+
+	deprecatedGrant       = "id=*;type=*;actions=read"
+	deprecatedGrantModern = "ids=*;type=*;actions=read"
+
+	projRoleWithDeprecatedGrant = fmt.Sprintf(`
+resource "boundary_role" "deprecated_grant" {
+	name          = "deprecated grant"
+	grant_strings = ["%s"]
+	scope_id      = boundary_scope.proj1.id
+	depends_on    = [boundary_role.proj1_admin]
+}`, deprecatedGrant)
+
+	projRoleWithDeprecatedGrantRewrite = fmt.Sprintf(`
+resource "boundary_role" "deprecated_grant" {
+	name                      = "deprecated grant"
+	grant_strings             = ["%s"]
+	rewrite_deprecated_grants = true
+	scope_id                  = boundary_scope.proj1.id
+	depends_on                = [boundary_role.proj1_admin]
+}`, deprecatedGrant)
 )
 
 func TestAccRoleToOrgToProject(t *testing.T) {
@@ -253,18 +324,12 @@ func TestAccRoleWithGrants(t *testing.T) {
 		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
 		Steps: []resource.TestStep{
 			{
-				// Create should return error due to invalid grant, however the role will still
-				// be created and should be set in state.
-				Config: testConfig(url, fooOrg, firstProjectFoo, projRoleWithInvalidGrants),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRoleResourceExists(provider, "boundary_role.with_grants"),
-					resource.TestCheckResourceAttr("boundary_role.with_grants", "name", "with_grants"),
-					resource.TestCheckResourceAttr("boundary_role.with_grants", "description", "with grants"),
-				),
-				ExpectError: regexp.MustCompile(`Improperly`),
+				// Invalid grants are rejected at plan time, before the role is created
+				Config:      testConfig(url, fooOrg, firstProjectFoo, projRoleWithInvalidGrants),
+				ExpectError: regexp.MustCompile(`unknown action "badaction"`),
 			},
 			{
-				// Create again with valid grants should succeed
+				// Create with valid grants should succeed
 				Config: testConfig(url, fooOrg, firstProjectFoo, projRoleWithGrants),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRoleResourceExists(provider, "boundary_role.with_grants"),
@@ -275,15 +340,9 @@ func TestAccRoleWithGrants(t *testing.T) {
 			},
 			importStep("boundary_role.with_grants"),
 			{
-				// Update should return error due to invalid grant, however the role will still
-				// be updated and should be set in state.
-				Config: testConfig(url, fooOrg, firstProjectFoo, projRoleWithInvalidGrantsUpdate),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRoleResourceExists(provider, "boundary_role.with_grants"),
-					resource.TestCheckResourceAttr("boundary_role.with_grants", "name", "with_grants_update"),
-					resource.TestCheckResourceAttr("boundary_role.with_grants", "description", "with grants update"),
-				),
-				ExpectError: regexp.MustCompile(`Improperly`),
+				// Invalid grants are rejected at plan time, leaving the role untouched
+				Config:      testConfig(url, fooOrg, firstProjectFoo, projRoleWithInvalidGrantsUpdate),
+				ExpectError: regexp.MustCompile(`unknown action "badaction"`),
 			},
 			{
 				// Update should now succeed
@@ -300,6 +359,49 @@ func TestAccRoleWithGrants(t *testing.T) {
 	})
 }
 
+func TestAccRoleWithGrantBlocks(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// Invalid grant blocks are rejected at plan time
+				Config:      testConfig(url, fooOrg, firstProjectFoo, projRoleWithInvalidGrantBlock),
+				ExpectError: regexp.MustCompile(`action "authorize-session" is not valid for type host-set`),
+			},
+			{
+				// Grant blocks are rendered to canonical grant strings
+				Config: testConfig(url, fooOrg, firstProjectFoo, projRoleWithGrantBlocks),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleResourceExists(provider, "boundary_role.with_grants"),
+					testAccCheckRoleResourceGrantsSet(provider, "boundary_role.with_grants", []string{readonlyGrant, targetGrantCanonical}),
+					resource.TestCheckResourceAttr("boundary_role.with_grants", "grant_strings.#", "1"),
+					resource.TestCheckResourceAttr("boundary_role.with_grants", "grant.#", "1"),
+				),
+			},
+			{
+				// Reordering the components of a grant block does not produce a diff
+				Config:   testConfig(url, fooOrg, firstProjectFoo, projRoleWithGrantBlocksReordered),
+				PlanOnly: true,
+			},
+			{
+				// Removing the grant blocks leaves only the grant strings
+				Config: testConfig(url, fooOrg, firstProjectFoo, projRoleWithGrants),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleResourceGrantsSet(provider, "boundary_role.with_grants", []string{readonlyGrant}),
+					resource.TestCheckResourceAttr("boundary_role.with_grants", "grant.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoleWithPrincipals(t *testing.T) {
 	tc := controller.NewTestController(t, tcConfig...)
 	t.Cleanup(tc.Shutdown)
@@ -401,6 +503,46 @@ func TestAccRoleWithGroups(t *testing.T) {
 	})
 }
 
+// TestAccRoleRewriteDeprecatedGrants creates a role with a deprecated grant
+// and then opts into rewriting it
+func TestAccRoleRewriteDeprecatedGrants(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckRoleResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, projRoleWithDeprecatedGrant),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleResourceGrantsSet(provider, "boundary_role.deprecated_grant", []string{deprecatedGrant}),
+					resource.TestCheckResourceAttr("boundary_role.deprecated_grant", roleRewriteDeprecatedGrantsKey, "false"),
+				),
+			},
+			importStep("boundary_role.deprecated_grant"),
+			{
+				// Opting in rewrites the grant in Boundary and in the state
+				Config: testConfig(url, fooOrg, firstProjectFoo, projRoleWithDeprecatedGrantRewrite),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleResourceGrantsSet(provider, "boundary_role.deprecated_grant", []string{deprecatedGrantModern}),
+					resource.TestCheckTypeSetElemAttr("boundary_role.deprecated_grant", "grant_strings.*", deprecatedGrantModern),
+					resource.TestCheckResourceAttr("boundary_role.deprecated_grant", roleRewrittenGrantsKey+".%", "1"),
+					resource.TestCheckResourceAttr("boundary_role.deprecated_grant", roleRewrittenGrantsKey+"."+deprecatedGrant, deprecatedGrantModern),
+				),
+			},
+			{
+				// The deprecated grant in the configuration matches the rewritten one
+				Config:   testConfig(url, fooOrg, firstProjectFoo, projRoleWithDeprecatedGrantRewrite),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 // testAccCheckRoleDestroyed checks the terraform state for the host
 // catalog and returns an error if found.
 //
@@ -644,3 +786,47 @@ func testAccCheckRoleResourceDestroy(t *testing.T, testProvider *schema.Provider
 		return nil
 	}
 }
+
+func TestRoleGrantChanges(t *testing.T) {
+	r := resourceRole()
+	prior := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		ScopeIdKey:          "global",
+		roleGrantStringsKey: []interface{}{"ids=*;type=*;actions=read", "ids=*;type=target;actions=authorize-session"},
+		roleGrantKey: []interface{}{map[string]interface{}{
+			roleGrantIdsKey:     []interface{}{"*"},
+			roleGrantTypeKey:    "host",
+			roleGrantActionsKey: []interface{}{"read"},
+		}},
+	})
+	prior.SetId("r_1234567890")
+
+	d := r.Data(prior.State())
+	if err := d.Set(roleGrantStringsKey, []interface{}{"ids=*;type=*;actions=read", "ids=*;type=user;actions=read"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Set(roleGrantKey, []interface{}{}); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, added := roleGrantChanges(d, []string{"ids=*;type=*;actions=read", "ids=*;type=user;actions=read"})
+	slices.Sort(removed)
+	if want := []string{"ids=*;type=host;actions=read", "ids=*;type=target;actions=authorize-session"}; !slices.Equal(removed, want) {
+		t.Errorf("got removed grants %q, want %q", removed, want)
+	}
+	if want := []string{"ids=*;type=user;actions=read"}; !slices.Equal(added, want) {
+		t.Errorf("got added grants %q, want %q", added, want)
+	}
+}
+
+func TestResourceRoleStateUpgradeV0(t *testing.T) {
+	got, err := resourceRoleStateUpgradeV0(context.Background(), map[string]interface{}{
+		IDKey:               "r_1234567890",
+		roleGrantStringsKey: []interface{}{deprecatedGrant},
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		IDKey:                          "r_1234567890",
+		roleGrantStringsKey:            []interface{}{deprecatedGrant},
+		roleRewriteDeprecatedGrantsKey: false,
+	}, got)
+}
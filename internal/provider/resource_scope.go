@@ -5,10 +5,14 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/roles"
 	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,6 +21,16 @@ const (
 	scopeGlobalScopeKey        = "global_scope"
 	scopeAutoCreateAdminRole   = "auto_create_admin_role"
 	scopeAutoCreateDefaultRole = "auto_create_default_role"
+	scopeAdminRoleIdKey        = "admin_role_id"
+	scopeDefaultRoleIdKey      = "default_role_id"
+	scopeAdminRoleKey          = "admin_role"
+	scopeDefaultRoleKey        = "default_role"
+
+	// Names Boundary gives the roles it creates along with a scope, their
+	// descriptions mention the ID of the scope.
+	scopeAdminRoleName          = "Administration"
+	scopeDefaultRoleName        = "Login and Default Grants"
+	scopeProjectDefaultRoleName = "Default Grants"
 )
 
 func resourceScope() *schema.Resource {
@@ -28,8 +42,9 @@ func resourceScope() *schema.Resource {
 		UpdateContext: resourceScopeUpdate,
 		DeleteContext: resourceScopeDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceScopeImport,
 		},
+		CustomizeDiff: resourceScopeCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			IDKey: {
@@ -48,30 +63,264 @@ func resourceScope() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID containing the sub scope resource.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The scope ID containing the sub scope resource.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(orgScopeIds...),
 			},
+			deletionProtectionKey: deletionProtectionSchema("scope"),
+			forceDestroyKey:       forceDestroySchema("scope"),
 			scopeGlobalScopeKey: {
-				Description: "Indicates that the scope containing this value is the global scope, which triggers some specialized behavior to allow it to be imported and managed.",
+				Description: "Indicates that the scope containing this value is the global scope, which triggers some specialized behavior to allow it to be imported and managed. Consider the `boundary_global_scope` resource instead.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 			},
 			scopeAutoCreateAdminRole: {
-				Description: "If set, when a new scope is created, the provider will not disable the functionality that automatically creates a role in the new scope and gives permissions to manage the scope to the provider's user. Marking this true makes for simpler HCL but results in role resources that are unmanaged by Terraform.",
+				Description: "If set, when a new scope is created, the provider will not disable the functionality that automatically creates a role in the new scope and gives permissions to manage the scope to the provider's user. Marking this true makes for simpler HCL but results in role resources that are unmanaged by Terraform, unless they are adopted with `admin_role`.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 			},
 			scopeAutoCreateDefaultRole: {
-				Description: "Only relevant when creating an org scope. If set, when a new scope is created, the provider will not disable the functionality that automatically creates a role in the new scope and gives listing of scopes and auth methods and the ability to authenticate to the anonymous user. Marking this true makes for simpler HCL but results in role resources that are unmanaged by Terraform.",
+				Description: "Only relevant when creating an org scope. If set, when a new scope is created, the provider will not disable the functionality that automatically creates a role in the new scope and gives listing of scopes and auth methods and the ability to authenticate to the anonymous user. Marking this true makes for simpler HCL but results in role resources that are unmanaged by Terraform, unless they are adopted with `default_role`.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 			},
+			scopeAdminRoleIdKey: {
+				Description: "The ID of the role created along with the scope when `auto_create_admin_role` is set. " +
+					"The role is recognized by the name and description Boundary gives it, and is not found once they " +
+					"are changed.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			scopeDefaultRoleIdKey: {
+				Description: "The ID of the role created along with the scope when `auto_create_default_role` is set. " +
+					"The role is recognized by the name and description Boundary gives it, and is not found once they " +
+					"are changed.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			scopeAdminRoleKey: scopeRoleSchema("Adopts the role created by `auto_create_admin_role`, managing its grants, " +
+				"principals and grant scopes like a `boundary_role`. Requires `auto_create_admin_role`."),
+			scopeDefaultRoleKey: scopeRoleSchema("Adopts the role created by `auto_create_default_role`, managing its grants, " +
+				"principals and grant scopes like a `boundary_role`. Requires `auto_create_default_role`."),
 		},
 	}
 }
 
+func scopeRoleSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				roleGrantStringsKey: {
+					Description: "A list of stringified grants for the role. Grants are validated at plan time. " +
+						"If omitted, the grants Boundary set on the role are kept.",
+					Type:     schema.TypeSet,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateGrantString,
+					},
+				},
+				rolePrincipalIdsKey: {
+					Description: "A list of principal (user or group) IDs to add as principals on the role. If " +
+						"omitted, the principals Boundary set on the role are kept, such as the provider's user on " +
+						"the admin role.",
+					Type:     schema.TypeSet,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validateId(principalIds...),
+					},
+				},
+				roleGrantScopeIdsKey: {
+					Description: `A list of scopes for which the grants in this role should apply, which can include the special values "this", "children", or "descendants". ` +
+						"If omitted, the grant scopes Boundary set on the role are kept.",
+					Type:     schema.TypeSet,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validateGrantScopeId,
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceScopeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	for roleKey, autoCreateKey := range map[string]string{
+		scopeAdminRoleKey:   scopeAutoCreateAdminRole,
+		scopeDefaultRoleKey: scopeAutoCreateDefaultRole,
+	} {
+		if len(d.Get(roleKey).([]interface{})) > 0 && !d.Get(autoCreateKey).(bool) {
+			return fmt.Errorf("%q requires %q to be set", roleKey, autoCreateKey)
+		}
+		// The role only exists if it was created along with the scope.
+		if d.Id() != "" && d.HasChange(roleKey) && d.Get(scopeRoleIdKey(roleKey)).(string) == "" &&
+			len(d.Get(roleKey).([]interface{})) > 0 {
+			return fmt.Errorf("%q cannot be adopted because the scope has no auto-created role", roleKey)
+		}
+	}
+	return nil
+}
+
+func scopeRoleIdKey(roleKey string) string {
+	if roleKey == scopeAdminRoleKey {
+		return scopeAdminRoleIdKey
+	}
+	return scopeDefaultRoleIdKey
+}
+
+// findScopeAutoCreatedRoles looks up the roles Boundary creates along with a
+// scope.
+func findScopeAutoCreatedRoles(ctx context.Context, md *metaData, scopeId string) (adminRoleId, defaultRoleId string, err error) {
+	rlr, err := roles.NewClient(md.client).List(ctx, scopeId)
+	if err != nil {
+		return "", "", err
+	}
+	adminRoleId, defaultRoleId = matchScopeAutoCreatedRoles(scopeId, rlr.GetItems())
+	return adminRoleId, defaultRoleId, nil
+}
+
+// matchScopeAutoCreatedRoles picks the roles Boundary created along with a
+// scope among its roles. Boundary does not flag them, so they are recognized
+// by the names it gives them and their descriptions, which mention the scope:
+// "Role created for administration of scope <id> by user <id> at its creation
+// time" for the admin role, and "Role created ... of scope <id> at its
+// creation time" for the default role. A role whose name or description was
+// changed is not recognized.
+func matchScopeAutoCreatedRoles(scopeId string, rs []*roles.Role) (adminRoleId, defaultRoleId string) {
+	for _, r := range rs {
+		if !strings.HasPrefix(r.Description, "Role created ") || !strings.HasSuffix(r.Description, " at its creation time") {
+			continue
+		}
+		switch r.Name {
+		case scopeAdminRoleName:
+			if strings.HasPrefix(r.Description, fmt.Sprintf("Role created for administration of scope %s by user ", scopeId)) {
+				adminRoleId = r.Id
+			}
+		case scopeDefaultRoleName, scopeProjectDefaultRoleName:
+			if strings.HasSuffix(r.Description, fmt.Sprintf(" of scope %s at its creation time", scopeId)) {
+				defaultRoleId = r.Id
+			}
+		}
+	}
+	return adminRoleId, defaultRoleId
+}
+
+// setScopeAutoCreatedRoles looks up the roles created along with the scope
+// and sets the IDs that are not known yet.
+func setScopeAutoCreatedRoles(ctx context.Context, md *metaData, d *schema.ResourceData) error {
+	adminRoleId, defaultRoleId, err := findScopeAutoCreatedRoles(ctx, md, d.Id())
+	if err != nil {
+		return fmt.Errorf("error finding auto-created roles: %w", err)
+	}
+	if d.Get(scopeAdminRoleIdKey).(string) == "" {
+		if err := d.Set(scopeAdminRoleIdKey, adminRoleId); err != nil {
+			return err
+		}
+	}
+	if d.Get(scopeDefaultRoleIdKey).(string) == "" {
+		if err := d.Set(scopeDefaultRoleIdKey, defaultRoleId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scopeRoleAttrConfigured reports whether an attribute of the nested block of
+// an adopted role is set in the configuration. Attributes that are not set
+// are left as they are on the role.
+func scopeRoleAttrConfigured(d *schema.ResourceData, roleKey, key string) bool {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return false
+	}
+	block := raw.GetAttr(roleKey)
+	if block.IsNull() || !block.IsKnown() || block.LengthInt() == 0 {
+		return false
+	}
+	return !block.Index(cty.NumberIntVal(0)).GetAttr(key).IsNull()
+}
+
+// reconcileScopeRole sets the grants, principals and grant scopes of an
+// adopted role to the ones in its nested configuration.
+func reconcileScopeRole(ctx context.Context, md *metaData, d *schema.ResourceData, roleKey string) diag.Diagnostics {
+	roleId := d.Get(scopeRoleIdKey(roleKey)).(string)
+	list := d.Get(roleKey).([]interface{})
+	if roleId == "" || len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	cfg := list[0].(map[string]interface{})
+	setGrants := scopeRoleAttrConfigured(d, roleKey, roleGrantStringsKey)
+	setPrincipals := scopeRoleAttrConfigured(d, roleKey, rolePrincipalIdsKey)
+	var grantStrings []string
+	if setGrants {
+		grantStrings = setToStrings(cfg[roleGrantStringsKey])
+	}
+	grantScopeIds := setToStrings(cfg[roleGrantScopeIdsKey])
+
+	diags := md.grantPolicy.check(d.Id(), grantStrings, grantScopeIds)
+	if diags.HasError() {
+		return diags
+	}
+
+	rc := roles.NewClient(md.client)
+	if setGrants {
+		if _, err := rc.SetGrants(ctx, roleId, 0, grantStrings, roles.WithAutomaticVersioning(true)); err != nil {
+			return append(diags, diag.Errorf("error setting grants on %s: %v", roleKey, err)...)
+		}
+	}
+	if setPrincipals {
+		if _, err := rc.SetPrincipals(ctx, roleId, 0, setToStrings(cfg[rolePrincipalIdsKey]), roles.WithAutomaticVersioning(true)); err != nil {
+			return append(diags, diag.Errorf("error setting principals on %s: %v", roleKey, err)...)
+		}
+	}
+	if len(grantScopeIds) > 0 {
+		if _, err := rc.SetGrantScopes(ctx, roleId, 0, grantScopeIds, roles.WithAutomaticVersioning(true)); err != nil {
+			return append(diags, diag.Errorf("error setting grant scopes on %s: %v", roleKey, err)...)
+		}
+	}
+	return diags
+}
+
+// readScopeRole refreshes the nested configuration of an adopted role. The
+// role ID is cleared if the role no longer exists.
+func readScopeRole(ctx context.Context, md *metaData, d *schema.ResourceData, roleKey string) error {
+	idKey := scopeRoleIdKey(roleKey)
+	roleId := d.Get(idKey).(string)
+	if roleId == "" {
+		return nil
+	}
+	rrr, err := roles.NewClient(md.client).Read(ctx, roleId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			if err := d.Set(idKey, ""); err != nil {
+				return err
+			}
+			return d.Set(roleKey, nil)
+		}
+		return fmt.Errorf("error reading %s: %w", roleKey, err)
+	}
+	if len(d.Get(roleKey).([]interface{})) == 0 {
+		return nil
+	}
+	r := rrr.GetItem()
+	return d.Set(roleKey, []interface{}{map[string]interface{}{
+		roleGrantStringsKey:  r.GrantStrings,
+		rolePrincipalIdsKey:  r.PrincipalIds,
+		roleGrantScopeIdsKey: r.GrantScopeIds,
+	}})
+}
+
 func setFromScopeResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
 	if err := d.Set(NameKey, raw["name"]); err != nil {
 		return err
@@ -132,12 +381,7 @@ func resourceScopeCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	// source from the current token, so that the user can be introspected when
 	// defining these roles instead of having to be explicitly defined in
 	// config.
-	if !d.Get(scopeAutoCreateAdminRole).(bool) {
-		opts = append(opts, scopes.WithSkipAdminRoleCreation(true))
-	}
-	if !d.Get(scopeAutoCreateDefaultRole).(bool) {
-		opts = append(opts, scopes.WithSkipDefaultRoleCreation(true))
-	}
+	opts = append(opts, scopeAutoRoleOpts(d.Get(scopeAutoCreateAdminRole).(bool), d.Get(scopeAutoCreateDefaultRole).(bool))...)
 
 	scp := scopes.NewClient(md.client)
 
@@ -153,7 +397,34 @@ func resourceScopeCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
-	return nil
+	if d.Get(scopeAutoCreateAdminRole).(bool) || d.Get(scopeAutoCreateDefaultRole).(bool) {
+		if err := setScopeAutoCreatedRoles(ctx, md, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var diags diag.Diagnostics
+	for _, roleKey := range []string{scopeAdminRoleKey, scopeDefaultRoleKey} {
+		diags = append(diags, reconcileScopeRole(ctx, md, d, roleKey)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceScopeRead(ctx, d, meta)...)
+}
+
+// scopeAutoRoleOpts returns the options that skip the creation of the roles
+// Boundary creates along with a scope, unless they are requested.
+func scopeAutoRoleOpts(autoCreateAdminRole, autoCreateDefaultRole bool) []scopes.Option {
+	var opts []scopes.Option
+	if !autoCreateAdminRole {
+		opts = append(opts, scopes.WithSkipAdminRoleCreation(true))
+	}
+	if !autoCreateDefaultRole {
+		opts = append(opts, scopes.WithSkipDefaultRoleCreation(true))
+	}
+	return opts
 }
 
 func resourceScopeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -176,6 +447,22 @@ func resourceScopeRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.FromErr(err)
 	}
 
+	// Scopes created before the role IDs were tracked get them on refresh.
+	missingRoleId := d.Get(scopeAdminRoleIdKey).(string) == "" || d.Get(scopeDefaultRoleIdKey).(string) == ""
+	wantsRoles := d.Get(scopeAutoCreateAdminRole).(bool) || d.Get(scopeAutoCreateDefaultRole).(bool) ||
+		len(d.Get(scopeAdminRoleKey).([]interface{})) > 0 || len(d.Get(scopeDefaultRoleKey).([]interface{})) > 0
+	if d.Id() != "global" && missingRoleId && wantsRoles {
+		if err := setScopeAutoCreatedRoles(ctx, md, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, roleKey := range []string{scopeAdminRoleKey, scopeDefaultRoleKey} {
+		if err := readScopeRole(ctx, md, d, roleKey); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
@@ -226,7 +513,29 @@ func resourceScopeUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
-	return nil
+	var diags diag.Diagnostics
+	for _, roleKey := range []string{scopeAdminRoleKey, scopeDefaultRoleKey} {
+		if d.HasChange(roleKey) {
+			diags = append(diags, reconcileScopeRole(ctx, md, d, roleKey)...)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceScopeRead(ctx, d, meta)...)
+}
+
+// resourceScopeImport looks up the roles created along with the scope, so
+// that they can be adopted.
+func resourceScopeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() == "global" {
+		return []*schema.ResourceData{d}, nil
+	}
+	if err := setScopeAutoCreatedRoles(ctx, meta.(*metaData), d); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
 }
 
 func resourceScopeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -235,6 +544,10 @@ func resourceScopeDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	md := meta.(*metaData)
+	if diags := checkDeletionProtection(ctx, md, d, "scope", countScopeChildren); diags.HasError() {
+		return diags
+	}
+
 	scp := scopes.NewClient(md.client)
 
 	_, err := scp.Delete(ctx, d.Id())
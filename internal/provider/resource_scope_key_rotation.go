@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	scopeKeyRotationTriggerKey = "rotation_trigger"
+	scopeKeyRotationRewrapKey  = "rewrap"
+	scopeKeysKey               = "keys"
+	scopeKeyPurposeKey         = "purpose"
+	scopeKeyTypeKey            = "type"
+	scopeKeyCreatedTimeKey     = "created_time"
+	scopeKeyVersionsKey        = "versions"
+	scopeKeyVersionKey         = "version"
+)
+
+func resourceScopeKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		Description: "The scope key rotation resource rotates the KMS keys of a Boundary scope when it is created, " +
+			"and exposes the keys of the scope. Change `rotation_trigger` to rotate the keys again. Destroying the " +
+			"resource only removes it from the state.",
+
+		CreateContext: resourceScopeKeyRotationCreate,
+		ReadContext:   resourceScopeKeyRotationRead,
+		DeleteContext: resourceScopeKeyRotationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceScopeKeyRotationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the scope key rotation, which is the ID of the scope.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description:      "The ID of the scope whose keys are rotated.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(anyScopeIds...),
+			},
+			scopeKeyRotationTriggerKey: {
+				Description: "An arbitrary value that causes the keys to be rotated again whenever it changes, " +
+					"e.g. a date from the `time_rotating` resource.",
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			scopeKeyRotationRewrapKey: {
+				Description: "When true, the existing data keys are rewrapped with the new root key version.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			scopeKeysKey: {
+				Description: "The KMS keys of the scope.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDKey: {
+							Description: "The ID of the key.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						scopeKeyPurposeKey: {
+							Description: "The purpose of the key, e.g. `database` or `sessions`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						scopeKeyTypeKey: {
+							Description: "The type of the key, either `kek` or `dek`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						scopeKeyCreatedTimeKey: {
+							Description: "The time the key was created.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						scopeKeyVersionsKey: {
+							Description: "The versions of the key.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									IDKey: {
+										Description: "The ID of the key version.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									scopeKeyVersionKey: {
+										Description: "The version number.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									scopeKeyCreatedTimeKey: {
+										Description: "The time the key version was created.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenScopeKeys(keys []*scopes.Key) []interface{} {
+	ret := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		versions := make([]interface{}, 0, len(k.Versions))
+		for _, v := range k.Versions {
+			versions = append(versions, map[string]interface{}{
+				IDKey:                  v.Id,
+				scopeKeyVersionKey:     int(v.Version),
+				scopeKeyCreatedTimeKey: v.CreatedTime.Format(time.RFC3339),
+			})
+		}
+		ret = append(ret, map[string]interface{}{
+			IDKey:                  k.Id,
+			scopeKeyPurposeKey:     k.Purpose,
+			scopeKeyTypeKey:        k.Type,
+			scopeKeyCreatedTimeKey: k.CreatedTime.Format(time.RFC3339),
+			scopeKeyVersionsKey:    versions,
+		})
+	}
+	return ret
+}
+
+func resourceScopeKeyRotationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	scopeId := d.Get(ScopeIdKey).(string)
+	if _, err := scp.RotateKeys(ctx, scopeId, d.Get(scopeKeyRotationRewrapKey).(bool)); err != nil {
+		return diag.Errorf("error rotating scope keys: %v", err)
+	}
+
+	d.SetId(scopeId)
+
+	return resourceScopeKeyRotationRead(ctx, d, meta)
+}
+
+func resourceScopeKeyRotationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	klr, err := scp.ListKeys(ctx, d.Get(ScopeIdKey).(string))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			// the scope is gone, destroy this resource
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error listing scope keys: %v", err)
+	}
+
+	if err := d.Set(scopeKeysKey, flattenScopeKeys(klr.GetItems())); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceScopeKeyRotationDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Key rotations cannot be undone, so this only removes the resource from
+	// the state.
+	return nil
+}
+
+func resourceScopeKeyRotationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set(ScopeIdKey, d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
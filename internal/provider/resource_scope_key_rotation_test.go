@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	orgKeyRotation = `
+resource "boundary_scope_key_rotation" "org1" {
+	scope_id         = boundary_scope.org1.id
+	rotation_trigger = "first"
+	depends_on       = [boundary_role.org1_admin]
+}`
+
+	orgKeyRotationUpdate = `
+resource "boundary_scope_key_rotation" "org1" {
+	scope_id         = boundary_scope.org1.id
+	rotation_trigger = "second"
+	rewrap           = true
+	depends_on       = [boundary_role.org1_admin]
+}`
+
+	// The first version of the database key disappears once it is destroyed,
+	// so the destruction ignores later changes of its key version.
+	orgKeyVersionDestruction = `
+locals {
+	database_key = one([for k in boundary_scope_key_rotation.org1.keys : k if k.purpose == "database"])
+}
+
+resource "boundary_scope_key_version_destruction" "database_v1" {
+	scope_id       = boundary_scope.org1.id
+	key_version_id = coalesce(one([for v in local.database_key.versions : v.id if v.version == 1]), "destroyed")
+
+	lifecycle {
+		ignore_changes = [key_version_id]
+	}
+}`
+)
+
+func TestAccScopeKeyRotation(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckScopeResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, orgKeyRotation),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("boundary_scope_key_rotation.org1", ScopeIdKey, "boundary_scope.org1", IDKey),
+					testAccCheckScopeKeyVersions("boundary_scope_key_rotation.org1", "database", 2),
+				),
+			},
+			importStep("boundary_scope_key_rotation.org1", scopeKeyRotationTriggerKey, scopeKeyRotationRewrapKey),
+			{
+				// changing the trigger rotates the keys again
+				Config: testConfig(url, fooOrg, orgKeyRotationUpdate),
+				Check:  testAccCheckScopeKeyVersions("boundary_scope_key_rotation.org1", "database", 3),
+			},
+			{
+				Config: testConfig(url, fooOrg, orgKeyRotationUpdate, orgKeyVersionDestruction),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("boundary_scope_key_version_destruction.database_v1", keyVersionDestructionStatusKey),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckScopeKeyVersions checks the number of versions of the key with
+// the given purpose in the state of a scope key rotation.
+func testAccCheckScopeKeyVersions(name, purpose string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("scope key rotation not found: %s", name)
+		}
+		attrs := rs.Primary.Attributes
+		keys, err := strconv.Atoi(attrs[scopeKeysKey+".#"])
+		if err != nil {
+			return fmt.Errorf("no keys found on %s: %w", name, err)
+		}
+		for i := 0; i < keys; i++ {
+			prefix := fmt.Sprintf("%s.%d.", scopeKeysKey, i)
+			if attrs[prefix+scopeKeyPurposeKey] != purpose {
+				continue
+			}
+			if got := attrs[prefix+scopeKeyVersionsKey+".#"]; got != strconv.Itoa(want) {
+				return fmt.Errorf("%s key has %s versions, want %d", purpose, got, want)
+			}
+			return nil
+		}
+		return fmt.Errorf("no %s key found on %s", purpose, name)
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	keyVersionIdKey                   = "key_version_id"
+	keyVersionDestructionStatusKey    = "status"
+	keyVersionDestructionCompletedKey = "completed_count"
+	keyVersionDestructionTotalKey     = "total_count"
+
+	// keyVersionDestructionCompleted is the status reported once the key
+	// version is gone. Boundary only lists the jobs that are still running.
+	keyVersionDestructionCompleted = "completed"
+)
+
+func resourceScopeKeyVersionDestruction() *schema.Resource {
+	return &schema.Resource{
+		Description: "The scope key version destruction resource requests the destruction of an old version of a " +
+			"scope KMS key, e.g. one listed by `boundary_scope_key_rotation`. Data encrypted with the key version is " +
+			"rewrapped first, which may run as a background job whose status is reported by this resource. " +
+			"Destroying the resource only removes it from the state.",
+
+		CreateContext: resourceScopeKeyVersionDestructionCreate,
+		ReadContext:   resourceScopeKeyVersionDestructionRead,
+		DeleteContext: resourceScopeKeyVersionDestructionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceScopeKeyVersionDestructionImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the key version destruction, in the form `<scope_id>:<key_version_id>`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description:      "The ID of the scope the key belongs to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(anyScopeIds...),
+			},
+			keyVersionIdKey: {
+				Description: "The ID of the key version to destroy. The current version of a key cannot be destroyed.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			keyVersionDestructionStatusKey: {
+				Description: "The status of the destruction job, e.g. `pending`, `running` or `completed`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			keyVersionDestructionCompletedKey: {
+				Description: "The number of rows rewrapped so far by the destruction job.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			keyVersionDestructionTotalKey: {
+				Description: "The total number of rows the destruction job has to rewrap.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceScopeKeyVersionDestructionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	scopeId := d.Get(ScopeIdKey).(string)
+	keyVersionId := d.Get(keyVersionIdKey).(string)
+
+	kdr, err := scp.DestroyKeyVersion(ctx, scopeId, keyVersionId)
+	if err != nil {
+		return diag.Errorf("error destroying key version: %v", err)
+	}
+
+	d.SetId(attachmentId(scopeId, keyVersionId))
+
+	// The state of the response is used rather than reading the jobs, which
+	// may not list the job yet, so that the resource is not dropped right
+	// after it is created. The counts are known once the job is read.
+	if err := d.Set(keyVersionDestructionStatusKey, kdr.State); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceScopeKeyVersionDestructionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	scopeId := d.Get(ScopeIdKey).(string)
+	keyVersionId := d.Get(keyVersionIdKey).(string)
+
+	jlr, err := scp.ListKeyVersionDestructionJobs(ctx, scopeId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			// the scope is gone, destroy this resource
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error listing key version destruction jobs: %v", err)
+	}
+	for _, job := range jlr.GetItems() {
+		if job.KeyVersionId != keyVersionId {
+			continue
+		}
+		if err := d.Set(keyVersionDestructionStatusKey, job.Status); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(keyVersionDestructionCompletedKey, int(job.CompletedCount)); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(keyVersionDestructionTotalKey, int(job.TotalCount)); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	// There is no running job, so the key version is either destroyed or its
+	// destruction was never requested.
+	klr, err := scp.ListKeys(ctx, scopeId)
+	if err != nil {
+		return diag.Errorf("error listing scope keys: %v", err)
+	}
+	for _, k := range klr.GetItems() {
+		for _, v := range k.Versions {
+			if v.Id == keyVersionId {
+				d.SetId("")
+				return nil
+			}
+		}
+	}
+	if err := d.Set(keyVersionDestructionStatusKey, keyVersionDestructionCompleted); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.Get(keyVersionDestructionTotalKey).(int) > 0 {
+		if err := d.Set(keyVersionDestructionCompletedKey, d.Get(keyVersionDestructionTotalKey)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceScopeKeyVersionDestructionDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Destroyed key versions cannot be restored, so this only removes the
+	// resource from the state.
+	return nil
+}
+
+func resourceScopeKeyVersionDestructionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitAttachmentId(d.Id(), 2)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set(ScopeIdKey, parts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set(keyVersionIdKey, parts[1]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
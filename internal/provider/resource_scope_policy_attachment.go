@@ -32,14 +32,16 @@ func resourceScopePolicyAttachment() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			ScopeIdKey: {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(orgScopeIds...),
 			},
 			policyIdKey: {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Required: true,
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				ValidateDiagFunc: validateId(storagePolicyIds),
 			},
 		},
 	}
@@ -7,9 +7,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
+	"slices"
 	"testing"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/roles"
 	"github.com/hashicorp/boundary/api/scopes"
 	"github.com/hashicorp/boundary/testing/controller"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -131,6 +134,234 @@ func TestAccScopeCreation(t *testing.T) {
 	})
 }
 
+const (
+	orgWithAdoptedRoles = `
+resource "boundary_scope" "adopted" {
+	name                     = "adopted"
+	scope_id                 = "global"
+	auto_create_admin_role   = true
+	auto_create_default_role = true
+
+	admin_role {
+		grant_strings = ["ids=*;type=*;actions=*"]
+	}
+
+	default_role {
+		grant_strings   = ["ids=*;type=scope;actions=list,no-op"]
+		principal_ids   = ["u_anon"]
+		grant_scope_ids = ["this"]
+	}
+}`
+
+	orgWithAdoptedRolesUpdate = `
+resource "boundary_scope" "adopted" {
+	name                     = "adopted"
+	scope_id                 = "global"
+	auto_create_admin_role   = true
+	auto_create_default_role = true
+
+	admin_role {
+		grant_strings = ["ids=*;type=*;actions=*"]
+	}
+
+	default_role {
+		grant_strings   = ["ids=*;type=scope;actions=list,no-op", "ids=*;type=auth-method;actions=list,authenticate"]
+		principal_ids   = ["u_anon"]
+		grant_scope_ids = ["this"]
+	}
+}`
+
+	orgWithInvalidAdoptedRole = `
+resource "boundary_scope" "adopted" {
+	name     = "adopted"
+	scope_id = "global"
+
+	admin_role {
+		grant_strings = ["ids=*;type=*;actions=*"]
+	}
+}`
+)
+
+func TestAccScopeAdoptedRoles(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckScopeResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfig(url, orgWithInvalidAdoptedRole),
+				ExpectError: regexp.MustCompile(`"admin_role" requires "auto_create_admin_role" to be set`),
+			},
+			{
+				Config: testConfig(url, orgWithAdoptedRoles),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScopeResourceExists(provider, "boundary_scope.adopted"),
+					resource.TestCheckResourceAttrSet("boundary_scope.adopted", scopeAdminRoleIdKey),
+					resource.TestCheckResourceAttrSet("boundary_scope.adopted", scopeDefaultRoleIdKey),
+					// The provider's user stays a principal of the admin role
+					resource.TestCheckResourceAttr("boundary_scope.adopted", "admin_role.0.principal_ids.#", "1"),
+					testAccCheckScopeAdoptedRoleGrants(provider, "boundary_scope.adopted", scopeDefaultRoleIdKey,
+						[]string{"ids=*;type=scope;actions=list,no-op"}),
+				),
+			},
+			// The role IDs are found on import, the flags and adopted roles are configuration only
+			importStep("boundary_scope.adopted", scopeAutoCreateAdminRole, scopeAutoCreateDefaultRole, scopeAdminRoleKey, scopeDefaultRoleKey),
+			{
+				Config: testConfig(url, orgWithAdoptedRolesUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScopeAdoptedRoleGrants(provider, "boundary_scope.adopted", scopeDefaultRoleIdKey,
+						[]string{"ids=*;type=scope;actions=list,no-op", "ids=*;type=auth-method;actions=list,authenticate"}),
+				),
+			},
+		},
+	})
+}
+
+const (
+	protectedOrg = `
+resource "boundary_scope" "protected" {
+	name                = "protected"
+	scope_id            = "global"
+	deletion_protection = true
+}`
+
+	unprotectedOrg = `
+resource "boundary_scope" "protected" {
+	name     = "protected"
+	scope_id = "global"
+}`
+
+	forceDestroyOrg = `
+resource "boundary_scope" "protected" {
+	name          = "protected"
+	scope_id      = "global"
+	force_destroy = true
+}`
+)
+
+func TestAccScopeDeletionProtection(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	var orgId string
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckScopeResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, protectedOrg),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScopeResourceExists(provider, "boundary_scope.protected"),
+					resource.TestCheckResourceAttrWith("boundary_scope.protected", IDKey, func(id string) error {
+						orgId = id
+						return nil
+					}),
+				),
+			},
+			{
+				Config:      testConfig(url),
+				ExpectError: regexp.MustCompile(`scope o_\w+ has deletion protection enabled`),
+			},
+			{
+				// Create a project outside of Terraform
+				PreConfig: func() {
+					md := provider.Meta().(*metaData)
+					_, err := scopes.NewClient(md.client).Create(context.Background(), orgId,
+						scopes.WithName("unmanaged"), scopes.WithSkipAdminRoleCreation(true), scopes.WithSkipDefaultRoleCreation(true))
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testConfig(url, unprotectedOrg),
+			},
+			{
+				Config:      testConfig(url),
+				ExpectError: regexp.MustCompile(`scope o_\w+ still contains 1 child resources`),
+			},
+			{
+				Config: testConfig(url, forceDestroyOrg),
+			},
+		},
+	})
+}
+
+func TestMatchScopeAutoCreatedRoles(t *testing.T) {
+	rs := []*roles.Role{
+		{
+			Id:          "r_other",
+			Name:        scopeAdminRoleName,
+			Description: "Role created for administration of scope o_other by user u_1234567890 at its creation time",
+		},
+		{
+			Id:          "r_renamed",
+			Name:        "Administrators",
+			Description: "Role created for administration of scope o_1234567890 by user u_1234567890 at its creation time",
+		},
+		{
+			Id:          "r_edited",
+			Name:        scopeDefaultRoleName,
+			Description: "Managed elsewhere",
+		},
+		{
+			Id:          "r_admin",
+			Name:        scopeAdminRoleName,
+			Description: "Role created for administration of scope o_1234567890 by user u_1234567890 at its creation time",
+		},
+		{
+			Id:   "r_default",
+			Name: scopeDefaultRoleName,
+			Description: "Role created for login capability, account self-management, and other default grants " +
+				"for users of scope o_1234567890 at its creation time",
+		},
+	}
+	adminRoleId, defaultRoleId := matchScopeAutoCreatedRoles("o_1234567890", rs)
+	if adminRoleId != "r_admin" || defaultRoleId != "r_default" {
+		t.Errorf("got admin role %q and default role %q, want %q and %q", adminRoleId, defaultRoleId, "r_admin", "r_default")
+	}
+
+	adminRoleId, defaultRoleId = matchScopeAutoCreatedRoles("p_1234567890", []*roles.Role{{
+		Id:          "r_default",
+		Name:        scopeProjectDefaultRoleName,
+		Description: "Role created to provide default grants to users of scope p_1234567890 at its creation time",
+	}})
+	if adminRoleId != "" || defaultRoleId != "r_default" {
+		t.Errorf("got admin role %q and default role %q, want %q and %q", adminRoleId, defaultRoleId, "", "r_default")
+	}
+}
+
+func testAccCheckScopeAdoptedRoleGrants(testProvider *schema.Provider, name, roleIdKey string, wantGrants []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		roleId := rs.Primary.Attributes[roleIdKey]
+		if roleId == "" {
+			return fmt.Errorf("%s is not set", roleIdKey)
+		}
+
+		md := testProvider.Meta().(*metaData)
+		rr, err := roles.NewClient(md.client).Read(context.Background(), roleId)
+		if err != nil {
+			return fmt.Errorf("Got an error when reading role %q: %v", roleId, err)
+		}
+		got := slices.Clone(rr.Item.GrantStrings)
+		slices.Sort(got)
+		want := slices.Clone(wantGrants)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("grants on role %q are %v, want %v", roleId, rr.Item.GrantStrings, wantGrants)
+		}
+		return nil
+	}
+}
+
 func testAccCheckScopeResourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
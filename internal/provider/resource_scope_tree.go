@@ -0,0 +1,438 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	scopeTreeProjectKey      = "project"
+	scopeTreeProjectKeyKey   = "key"
+	scopeTreeProjectIdsKey   = "project_ids"
+	scopeTreeProjectKeyIdKey = "project_key_ids"
+)
+
+func resourceScopeTree() *schema.Resource {
+	return &schema.Resource{
+		Description: "The scope tree resource allows you to configure a Boundary org scope and its project scopes " +
+			"as a single resource. Projects are created after the org and deleted before it. Deleting the tree, or " +
+			"removing a project from it, fails when a scope has deletion protection enabled or still contains " +
+			"child resources, unless `force_destroy` is set on the tree or on the project.",
+
+		CreateContext: resourceScopeTreeCreate,
+		ReadContext:   resourceScopeTreeRead,
+		UpdateContext: resourceScopeTreeUpdate,
+		DeleteContext: resourceScopeTreeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceScopeTreeImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the org scope.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			ScopeIdKey: {
+				Description:      "The scope ID containing the org, which must be `global`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(globalScopeIds),
+			},
+			NameKey: {
+				Description: "The org name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			DescriptionKey: {
+				Description: "The org description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			scopeAutoCreateAdminRole: {
+				Description: "If set, Boundary creates a role giving the provider's user permissions to manage the org " +
+					"and each project. Unless the user already has permissions in new orgs, this is required to create " +
+					"the projects. Only applies to scopes created after it is set.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			scopeAutoCreateDefaultRole: {
+				Description: "If set, Boundary creates its default roles in the org and each project. Only applies to " +
+					"scopes created after it is set.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			deletionProtectionKey: deletionProtectionSchema("scope tree"),
+			forceDestroyKey:       forceDestroySchema("scope tree"),
+			scopeTreeProjectKey: {
+				Description: "A project scope in the org.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						scopeTreeProjectKeyKey: {
+							Description: "A unique, stable identifier of the project within the tree. Changing the " +
+								"key deletes the project and creates a new one, while changing the name renames it.",
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						NameKey: {
+							Description: "The project name. Defaults to the key.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						DescriptionKey: {
+							Description: "The project description.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						deletionProtectionKey: deletionProtectionSchema("project"),
+						forceDestroyKey:       forceDestroySchema("project"),
+					},
+				},
+			},
+			scopeTreeProjectIdsKey: {
+				Description: "The IDs of the projects, by project name.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			scopeTreeProjectKeyIdKey: {
+				Description: "The IDs of the projects, by project key.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			if !d.NewValueKnown(scopeTreeProjectKey) {
+				return nil
+			}
+			seen := map[string]bool{}
+			for _, p := range d.Get(scopeTreeProjectKey).(*schema.Set).List() {
+				key := p.(map[string]interface{})[scopeTreeProjectKeyKey].(string)
+				if seen[key] {
+					return fmt.Errorf("project key %q is used more than once", key)
+				}
+				seen[key] = true
+			}
+			return nil
+		},
+	}
+}
+
+// scopeTreeProject is a project of a scope tree as configured.
+type scopeTreeProject struct {
+	name               string
+	description        string
+	deletionProtection bool
+	forceDestroy       bool
+}
+
+func scopeTreeProjectsFromConfig(d *schema.ResourceData) map[string]scopeTreeProject {
+	return scopeTreeProjects(d.Get(scopeTreeProjectKey).(*schema.Set))
+}
+
+// scopeTreeProjects returns the projects of a scope tree by key.
+func scopeTreeProjects(set *schema.Set) map[string]scopeTreeProject {
+	projects := map[string]scopeTreeProject{}
+	for _, raw := range set.List() {
+		p := raw.(map[string]interface{})
+		key := p[scopeTreeProjectKeyKey].(string)
+		name := p[NameKey].(string)
+		if name == "" {
+			name = key
+		}
+		projects[key] = scopeTreeProject{
+			name:               name,
+			description:        p[DescriptionKey].(string),
+			deletionProtection: p[deletionProtectionKey].(bool),
+			forceDestroy:       p[forceDestroyKey].(bool),
+		}
+	}
+	return projects
+}
+
+// checkScopeTreeProjectDeletion checks the deletion protection of a project
+// of a scope tree. force_destroy on the tree applies to its projects too.
+func checkScopeTreeProjectDeletion(ctx context.Context, md *metaData, d *schema.ResourceData, id string, p scopeTreeProject) diag.Diagnostics {
+	force := p.forceDestroy || d.Get(forceDestroyKey).(bool)
+	return checkDeletionProtectionOf(ctx, md, "project", id, p.deletionProtection, force, countScopeChildren)
+}
+
+// countScopeTreeOrgChildren counts the child resources of the org of a scope
+// tree, leaving out the given number of projects which are deleted with the
+// tree and checked separately.
+func countScopeTreeOrgChildren(projects int) childCounter {
+	return func(ctx context.Context, md *metaData, id string) ([]childCount, error) {
+		counts, err := countScopeChildren(ctx, md, id)
+		if err != nil {
+			return nil, err
+		}
+		for i := range counts {
+			if counts[i].name == "scopes" {
+				counts[i].count = max(counts[i].count-projects, 0)
+			}
+		}
+		return counts, nil
+	}
+}
+
+func resourceScopeTreeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	opts := []scopes.Option{scopes.WithName(d.Get(NameKey).(string))}
+	if desc, ok := d.GetOk(DescriptionKey); ok {
+		opts = append(opts, scopes.WithDescription(desc.(string)))
+	}
+	opts = append(opts, scopeAutoRoleOpts(d.Get(scopeAutoCreateAdminRole).(bool), d.Get(scopeAutoCreateDefaultRole).(bool))...)
+
+	scr, err := scp.Create(ctx, d.Get(ScopeIdKey).(string), opts...)
+	if err != nil {
+		return diag.Errorf("error creating org scope: %v", err)
+	}
+	d.SetId(scr.GetItem().Id)
+
+	keyIds := map[string]interface{}{}
+	diags := createScopeTreeProjects(ctx, d, scp, scopeTreeProjectsFromConfig(d), keyIds)
+	if err := d.Set(scopeTreeProjectKeyIdKey, keyIds); err != nil {
+		return diag.FromErr(err)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceScopeTreeRead(ctx, d, meta)
+}
+
+func createScopeTreeProjects(ctx context.Context, d *schema.ResourceData, scp *scopes.Client, projects map[string]scopeTreeProject, keyIds map[string]interface{}) diag.Diagnostics {
+	autoRoleOpts := scopeAutoRoleOpts(d.Get(scopeAutoCreateAdminRole).(bool), d.Get(scopeAutoCreateDefaultRole).(bool))
+	for key, p := range projects {
+		opts := append([]scopes.Option{scopes.WithName(p.name)}, autoRoleOpts...)
+		if p.description != "" {
+			opts = append(opts, scopes.WithDescription(p.description))
+		}
+		scr, err := scp.Create(ctx, d.Id(), opts...)
+		if err != nil {
+			return diag.Errorf("error creating project %q: %v", key, err)
+		}
+		keyIds[key] = scr.GetItem().Id
+	}
+	return nil
+}
+
+func resourceScopeTreeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	srr, err := scp.Read(ctx, d.Id())
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error calling read scope: %v", err)
+	}
+	org := srr.GetItem()
+	if err := d.Set(ScopeIdKey, org.ScopeId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(NameKey, org.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(DescriptionKey, org.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	slr, err := scp.List(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("error listing projects: %v", err)
+	}
+	byId := map[string]*scopes.Scope{}
+	for _, p := range slr.GetItems() {
+		byId[p.Id] = p
+	}
+
+	// names that default to the key are kept empty, as they are configured,
+	// and the deletion settings only exist in the configuration
+	configured := map[string]map[string]interface{}{}
+	for _, raw := range d.Get(scopeTreeProjectKey).(*schema.Set).List() {
+		p := raw.(map[string]interface{})
+		configured[p[scopeTreeProjectKeyKey].(string)] = p
+	}
+
+	// projects created outside of the tree are not part of it
+	keyIds := d.Get(scopeTreeProjectKeyIdKey).(map[string]interface{})
+	names := map[string]interface{}{}
+	var projects []interface{}
+	for key, id := range keyIds {
+		p, ok := byId[id.(string)]
+		if !ok {
+			delete(keyIds, key)
+			continue
+		}
+		names[p.Name] = p.Id
+		name := p.Name
+		c, ok := configured[key]
+		if ok && c[NameKey] == "" && name == key {
+			name = ""
+		}
+		project := map[string]interface{}{
+			scopeTreeProjectKeyKey: key,
+			NameKey:                name,
+			DescriptionKey:         p.Description,
+		}
+		if ok {
+			project[deletionProtectionKey] = c[deletionProtectionKey]
+			project[forceDestroyKey] = c[forceDestroyKey]
+		}
+		projects = append(projects, project)
+	}
+	if err := d.Set(scopeTreeProjectKey, projects); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(scopeTreeProjectKeyIdKey, keyIds); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(scopeTreeProjectIdsKey, names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceScopeTreeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	if d.HasChanges(NameKey, DescriptionKey) {
+		opts := []scopes.Option{scopes.WithName(d.Get(NameKey).(string)), scopes.DefaultDescription(), scopes.WithAutomaticVersioning(true)}
+		if desc, ok := d.GetOk(DescriptionKey); ok {
+			opts = append(opts, scopes.WithDescription(desc.(string)))
+		}
+		if _, err := scp.Update(ctx, d.Id(), 0, opts...); err != nil {
+			return diag.Errorf("error updating org scope: %v", err)
+		}
+	}
+
+	if d.HasChange(scopeTreeProjectKey) {
+		keyIds := d.Get(scopeTreeProjectKeyIdKey).(map[string]interface{})
+		projects := scopeTreeProjectsFromConfig(d)
+		o, _ := d.GetChange(scopeTreeProjectKey)
+		oldProjects := scopeTreeProjects(o.(*schema.Set))
+
+		// removed projects are checked with their previous settings, before
+		// any of them is deleted
+		var diags diag.Diagnostics
+		for key, id := range keyIds {
+			if _, ok := projects[key]; !ok {
+				diags = append(diags, checkScopeTreeProjectDeletion(ctx, md, d, id.(string), oldProjects[key])...)
+			}
+		}
+		if diags.HasError() {
+			return diags
+		}
+
+		// delete first, so that a project can take the name of a removed one
+		for key, id := range keyIds {
+			if _, ok := projects[key]; ok {
+				continue
+			}
+			if _, err := scp.Delete(ctx, id.(string)); err != nil {
+				if apiErr := api.AsServerError(err); apiErr == nil || apiErr.Response().StatusCode() != http.StatusNotFound {
+					return diag.Errorf("error deleting project %q: %v", key, err)
+				}
+			}
+			delete(keyIds, key)
+		}
+
+		toCreate := map[string]scopeTreeProject{}
+		for key, p := range projects {
+			id, ok := keyIds[key]
+			if !ok {
+				toCreate[key] = p
+				continue
+			}
+			opts := []scopes.Option{scopes.WithName(p.name), scopes.DefaultDescription(), scopes.WithAutomaticVersioning(true)}
+			if p.description != "" {
+				opts = append(opts, scopes.WithDescription(p.description))
+			}
+			if _, err := scp.Update(ctx, id.(string), 0, opts...); err != nil {
+				return diag.Errorf("error updating project %q: %v", key, err)
+			}
+		}
+
+		diags = createScopeTreeProjects(ctx, d, scp, toCreate, keyIds)
+		if err := d.Set(scopeTreeProjectKeyIdKey, keyIds); err != nil {
+			return diag.FromErr(err)
+		}
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceScopeTreeRead(ctx, d, meta)
+}
+
+func resourceScopeTreeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	scp := scopes.NewClient(md.client)
+
+	// every scope is checked before any of them is deleted
+	keyIds := d.Get(scopeTreeProjectKeyIdKey).(map[string]interface{})
+	projects := scopeTreeProjectsFromConfig(d)
+	diags := checkDeletionProtection(ctx, md, d, "scope tree", countScopeTreeOrgChildren(len(keyIds)))
+	for key, id := range keyIds {
+		diags = append(diags, checkScopeTreeProjectDeletion(ctx, md, d, id.(string), projects[key])...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	for key, id := range keyIds {
+		if _, err := scp.Delete(ctx, id.(string)); err != nil {
+			if apiErr := api.AsServerError(err); apiErr == nil || apiErr.Response().StatusCode() != http.StatusNotFound {
+				return diag.Errorf("error deleting project %q: %v", key, err)
+			}
+		}
+	}
+
+	if _, err := scp.Delete(ctx, d.Id()); err != nil {
+		return diag.Errorf("error deleting org scope: %v", err)
+	}
+
+	return nil
+}
+
+// resourceScopeTreeImport adopts all the projects of the org, keyed by name.
+func resourceScopeTreeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !orgIds.matches(d.Id()) {
+		return nil, fmt.Errorf("cannot import %q as a scope tree, expected the ID of an org", d.Id())
+	}
+
+	md := meta.(*metaData)
+	slr, err := scopes.NewClient(md.client).List(ctx, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error listing projects: %w", err)
+	}
+	keyIds := map[string]interface{}{}
+	for _, p := range slr.GetItems() {
+		keyIds[p.Name] = p.Id
+	}
+	if err := d.Set(scopeTreeProjectKeyIdKey, keyIds); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
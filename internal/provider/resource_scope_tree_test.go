@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/hostcatalogs"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	scopeTree = `
+resource "boundary_scope_tree" "tenant" {
+	scope_id               = "global"
+	name                   = "tenant"
+	auto_create_admin_role = true
+
+	project {
+		key         = "dev"
+		description = "development"
+	}
+
+	project {
+		key  = "prod"
+		name = "production"
+	}
+}`
+
+	scopeTreeUpdate = `
+resource "boundary_scope_tree" "tenant" {
+	scope_id               = "global"
+	name                   = "tenant-renamed"
+	auto_create_admin_role = true
+
+	project {
+		key  = "prod"
+		name = "prod"
+	}
+
+	project {
+		key = "staging"
+	}
+}`
+)
+
+// scopeTreeProtected is a scope tree with the settings of the tree and of the
+// dev project as arguments, and the dev project included when not empty.
+const scopeTreeProtected = `
+resource "boundary_scope_tree" "tenant" {
+	scope_id               = "global"
+	name                   = "tenant"
+	auto_create_admin_role = true
+	%s
+
+	project {
+		key = "prod"
+	}
+	%s
+}`
+
+func TestAccScopeTree(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	var prodId string
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckScopeResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, scopeTree),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScopeTreeProjects(provider, "boundary_scope_tree.tenant", "dev", "production"),
+					resource.TestCheckResourceAttrWith("boundary_scope_tree.tenant", scopeTreeProjectIdsKey+".production", func(id string) error {
+						prodId = id
+						return nil
+					}),
+				),
+			},
+			importStep("boundary_scope_tree.tenant", scopeAutoCreateAdminRole, scopeTreeProjectKey, scopeTreeProjectKeyIdKey),
+			{
+				// renaming a project keeps its ID, removing one deletes it
+				Config: testConfig(url, scopeTreeUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("boundary_scope_tree.tenant", NameKey, "tenant-renamed"),
+					testAccCheckScopeTreeProjects(provider, "boundary_scope_tree.tenant", "prod", "staging"),
+					resource.TestCheckResourceAttrWith("boundary_scope_tree.tenant", scopeTreeProjectIdsKey+".prod", func(id string) error {
+						if id != prodId {
+							return fmt.Errorf("renamed project has ID %q, want %q", id, prodId)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccScopeTreeDeletionProtection(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	const name = "boundary_scope_tree.tenant"
+	const protected = "deletion_protection = true"
+	dev := func(settings string) string {
+		return fmt.Sprintf("project {\n\t\tkey = \"dev\"\n\t\t%s\n\t}", settings)
+	}
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckScopeResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fmt.Sprintf(scopeTreeProtected, protected, dev(protected))),
+				Check:  testAccCheckScopeTreeProjects(provider, name, "dev", "prod"),
+			},
+			{
+				// removing a protected project fails
+				Config:      testConfig(url, fmt.Sprintf(scopeTreeProtected, protected, "")),
+				ExpectError: regexp.MustCompile(`project p_\w+ has deletion protection enabled`),
+			},
+			{
+				Config:      testConfig(url),
+				ExpectError: regexp.MustCompile(`scope tree o_\w+ has deletion protection enabled`),
+			},
+			{
+				// Create a host catalog in the dev project outside of Terraform
+				PreConfig: func() {
+					md := provider.Meta().(*metaData)
+					rs, err := scopes.NewClient(md.client).List(context.Background(), "global", scopes.WithRecursive(true), scopes.WithFilter(`"/item/name" == "dev"`))
+					if err != nil {
+						t.Fatal(err)
+					}
+					if len(rs.GetItems()) != 1 {
+						t.Fatalf("found %d dev projects", len(rs.GetItems()))
+					}
+					if _, err := hostcatalogs.NewClient(md.client).Create(context.Background(), "static", rs.GetItems()[0].Id); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testConfig(url, fmt.Sprintf(scopeTreeProtected, "", dev(""))),
+			},
+			{
+				// removing a project that is not empty fails
+				Config:      testConfig(url, fmt.Sprintf(scopeTreeProtected, "", "")),
+				ExpectError: regexp.MustCompile(`project p_\w+ still contains 1 child resources`),
+			},
+			{
+				Config: testConfig(url, fmt.Sprintf(scopeTreeProtected, "", dev("force_destroy = true"))),
+			},
+			{
+				Config: testConfig(url, fmt.Sprintf(scopeTreeProtected, "", "")),
+				Check:  testAccCheckScopeTreeProjects(provider, name, "prod"),
+			},
+			{
+				ResourceName:  name,
+				ImportState:   true,
+				ImportStateId: "global",
+				ExpectError:   regexp.MustCompile(`cannot import "global" as a scope tree, expected the ID of an org`),
+			},
+		},
+	})
+}
+
+// testAccCheckScopeTreeProjects checks that the org of a scope tree contains
+// exactly the projects with the given names.
+func testAccCheckScopeTreeProjects(testProvider *schema.Provider, name string, projects ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("scope tree not found: %s", name)
+		}
+
+		md := testProvider.Meta().(*metaData)
+		slr, err := scopes.NewClient(md.client).List(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error listing projects of %q: %w", rs.Primary.ID, err)
+		}
+		got := map[string]bool{}
+		for _, p := range slr.GetItems() {
+			got[p.Name] = true
+			if rs.Primary.Attributes[scopeTreeProjectIdsKey+"."+p.Name] != p.Id {
+				return fmt.Errorf("project %q is not in %s", p.Name, scopeTreeProjectIdsKey)
+			}
+		}
+		if len(got) != len(projects) {
+			return fmt.Errorf("org has projects %v, want %v", got, projects)
+		}
+		for _, p := range projects {
+			if !got[p] {
+				return fmt.Errorf("project %q not found", p)
+			}
+		}
+		return nil
+	}
+}
@@ -55,13 +55,14 @@ func resourceStorageBucket() *schema.Resource {
 				Optional:    true,
 			},
 			PluginIdKey: {
-				Description:   "The ID of the plugin that should back the resource. This or " + PluginNameKey + " must be defined.",
-				Type:          schema.TypeString,
-				ConflictsWith: []string{PluginNameKey},
-				ExactlyOneOf:  []string{PluginIdKey, PluginNameKey},
-				Optional:      true,
-				ForceNew:      true,
-				Computed:      true, // If name is provided this will be computed
+				Description:      "The ID of the plugin that should back the resource. This or " + PluginNameKey + " must be defined.",
+				Type:             schema.TypeString,
+				ConflictsWith:    []string{PluginNameKey},
+				ExactlyOneOf:     []string{PluginIdKey, PluginNameKey},
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true, // If name is provided this will be computed
+				ValidateDiagFunc: validateId(pluginIds),
 			},
 			PluginNameKey: {
 				Description:   "The name of the plugin that should back the resource. This or " + PluginIdKey + " must be defined.",
@@ -72,10 +73,11 @@ func resourceStorageBucket() *schema.Resource {
 				ForceNew:      true,
 			},
 			ScopeIdKey: {
-				Description: "The scope for this storage bucket.",
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Required:    true,
+				Description:      "The scope for this storage bucket.",
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				ValidateDiagFunc: validateId(orgScopeIds...),
 			},
 			SecretsJsonKey: {
 				Description: `The secrets for the storage bucket. Either values encoded with the "jsonencode" function, pre-escaped JSON string, ` +
@@ -139,8 +141,9 @@ func resourceStorageBucket() *schema.Resource {
 			WorkerFilterKey: {
 				Description: `Filters to the worker(s) that can handle requests for this storage bucket. The filter must match an existing ` +
 					`worker in order to create a storage bucket.`,
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateWorkerFilter,
 			},
 			internalForceUpdateKey: {
 				Description: "Internal only. Used to force update so that we can always check the value of secrets.",
@@ -6,8 +6,13 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"maps"
 	"math"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/targets"
@@ -23,6 +28,7 @@ const (
 	targetDefaultClientPortKey              = "default_client_port"
 	targetEnableSessionRecordingKey         = "enable_session_recording"
 	targetSessionMaxSecondsKey              = "session_max_seconds"
+	targetSessionMaxDurationKey             = "session_max_duration"
 	targetSessionConnectionLimitKey         = "session_connection_limit"
 	targetStorageBucketIdKey                = "storage_bucket_id"
 	targetWorkerFilterKey                   = "worker_filter"
@@ -34,122 +40,288 @@ const (
 	targetTypeSsh = "ssh"
 )
 
+// targetTypes are the target types supported by the provider.
+var targetTypes = []string{targetTypeTcp, targetTypeSsh}
+
+// targetTypeOnlyKeys maps the attributes that only apply to some target types
+// to those types.
+var targetTypeOnlyKeys = map[string][]string{
+	targetInjectedAppCredentialSourceIdsKey: {targetTypeSsh},
+	targetEnableSessionRecordingKey:         {targetTypeSsh},
+	targetStorageBucketIdKey:                {targetTypeSsh},
+}
+
 func resourceTarget() *schema.Resource {
 	return &schema.Resource{
 		Description: "The target resource allows you to configure a Boundary target.",
 
-		CreateContext: resourceTargetCreate,
-		ReadContext:   resourceTargetRead,
-		UpdateContext: resourceTargetUpdate,
+		CreateContext: resourceTargetCreate(""),
+		ReadContext:   resourceTargetRead(""),
+		UpdateContext: resourceTargetUpdate(""),
 		DeleteContext: resourceTargetDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: map[string]*schema.Schema{
-			IDKey: {
-				Description: "The ID of the target.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			NameKey: {
-				Description: "The target name. Defaults to the resource name.",
-				Type:        schema.TypeString,
-				Optional:    true,
-			},
-			DescriptionKey: {
-				Description: "The target description.",
-				Type:        schema.TypeString,
-				Optional:    true,
-			},
-			TypeKey: {
-				Description: "The target resource type.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-			},
-			ScopeIdKey: {
-				Description: "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-			},
-			targetDefaultPortKey: {
-				Description: "The default port for this target.",
-				Type:        schema.TypeInt,
-				Optional:    true,
-			},
-			targetDefaultClientPortKey: {
-				Description: "The default client port for this target.",
-				Type:        schema.TypeInt,
-				Optional:    true,
-			},
-			targetHostSourceIdsKey: {
-				Description:   "A list of host source ID's. Cannot be used alongside address.",
-				Type:          schema.TypeSet,
-				Optional:      true,
-				Elem:          &schema.Schema{Type: schema.TypeString},
-				ConflictsWith: []string{targetAddressKey},
-			},
-			targetBrokeredCredentialSourceIdsKey: {
-				Description: "A list of brokered credential source ID's.",
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-			},
-			targetInjectedAppCredentialSourceIdsKey: {
-				Description: "A list of injected application credential source ID's.",
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-			},
-			targetSessionMaxSecondsKey: {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
-			},
-			targetSessionConnectionLimitKey: {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
-			},
-			targetWorkerFilterKey: {
-				Description: "Boolean expression to filter the workers for this target",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Deprecated:  "Deprecated. Use `egress_worker_filter` and `ingress_worker_filter` instead",
-			},
-			targetWorkerEgressFilterKey: {
-				Description: "Boolean expression to filter the workers used to access this target",
-				Type:        schema.TypeString,
-				Optional:    true,
-			},
-			targetWorkerIngressFilterKey: {
-				Description: "HCP Only. Boolean expression to filter the workers a user will connect to when initiating a session against this target",
-				Type:        schema.TypeString,
-				Optional:    true,
-			},
-			targetAddressKey: {
-				Description:   "Optionally, a valid network address to connect to for this target. Cannot be used alongside host_source_ids.",
-				Type:          schema.TypeString,
-				Optional:      true,
-				ConflictsWith: []string{targetHostSourceIdsKey},
+		Schema:        targetSchema(""),
+		CustomizeDiff: resourceTargetCustomizeDiff(""),
+	}
+}
+
+// targetSchema returns the schema of the target resource for the given type.
+// The generic resource, with an empty type, has a type attribute and all the
+// attributes of all types.
+func targetSchema(targetType string) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		IDKey: {
+			Description: "The ID of the target.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		NameKey: {
+			Description: "The target name. Defaults to the resource name.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		DescriptionKey: {
+			Description: "The target description.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		TypeKey: {
+			Description: "The target resource type. One of `tcp` or `ssh`.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		ScopeIdKey: {
+			Description:      "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validateId(projectIds),
+		},
+		targetDefaultPortKey: {
+			Description: "The default port for this target.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+		},
+		targetDefaultClientPortKey: {
+			Description: "The default client port for this target.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+		},
+		targetHostSourceIdsKey: {
+			Description: "A list of host source ID's. Cannot be used alongside address. Also holds the IDs " +
+				"`host_sources` resolve to. To manage host sources with `boundary_target_host_source` instead, omit " +
+				"this attribute and add it to `ignore_changes`.",
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateId(hostSetIds...),
 			},
-			targetEnableSessionRecordingKey: {
-				Description: "HCP/Ent Only. Enable sessions recording for this target. Only applicable for SSH targets.",
-				Type:        schema.TypeBool,
-				Optional:    true,
+			ConflictsWith: []string{targetAddressKey},
+		},
+		targetHostSourcesKey: targetHostSourcesSchema(),
+		targetBrokeredCredentialSourceIdsKey: {
+			Description: "A list of brokered credential source ID's. To manage brokered credential sources with " +
+				"`boundary_target_credential_source` instead, omit this attribute and add it to `ignore_changes`.",
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateId(credentialSourceIds...),
 			},
-			targetStorageBucketIdKey: {
-				Description: "HCP/Ent Only. Storage bucket for this target. Only applicable for SSH targets.",
-				Type:        schema.TypeString,
-				Optional:    true,
+		},
+		targetInjectedAppCredentialSourceIdsKey: {
+			Description: "A list of injected application credential source ID's. To manage injected application credential " +
+				"sources with `boundary_target_credential_source` instead, omit this attribute and add it to `ignore_changes`.",
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateId(credentialSourceIds...),
 			},
 		},
+		targetSessionMaxSecondsKey: {
+			Description: "The maximum duration of the sessions of this target in seconds. Sessions cannot be " +
+				"unlimited, Boundary defaults to 28800 (8 hours).",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validateSessionMaxSeconds,
+			ConflictsWith:    []string{targetSessionMaxDurationKey},
+		},
+		targetSessionMaxDurationKey: {
+			Description: "The maximum duration of the sessions of this target as a duration string, such as `8h`. " +
+				"An alternative to `session_max_seconds`.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateDuration(1, math.MaxUint32),
+			DiffSuppressFunc: suppressEquivalentDuration,
+			ConflictsWith:    []string{targetSessionMaxSecondsKey},
+		},
+		targetSessionConnectionLimitKey: {
+			Description: "The maximum number of connections allowed in each session of this target, or -1 for an " +
+				"unlimited number. Boundary defaults to -1.",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validateSessionConnectionLimit,
+		},
+		targetWorkerFilterKey: {
+			Description:      "Boolean expression to filter the workers for this target",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateWorkerFilter,
+			Deprecated:       "Deprecated. Use `egress_worker_filter` and `ingress_worker_filter` instead",
+		},
+		targetWorkerEgressFilterKey: {
+			Description:      "Boolean expression to filter the workers used to access this target",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateWorkerFilter,
+		},
+		targetWorkerIngressFilterKey: {
+			Description:      "HCP Only. Boolean expression to filter the workers a user will connect to when initiating a session against this target",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateWorkerFilter,
+		},
+		targetAddressKey: {
+			Description:   "Optionally, a valid network address to connect to for this target. Cannot be used alongside host_source_ids.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{targetHostSourceIdsKey, targetHostSourcesKey},
+		},
+		targetEnableSessionRecordingKey: {
+			Description: "HCP/Ent Only. Enable sessions recording for this target. Only applicable for SSH targets.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		AttributesJsonKey: {
+			Description: "The attributes of the target as returned by Boundary, encoded as JSON. This includes the " +
+				"attributes without a dedicated argument, such as the ones of newer target types.",
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		targetStorageBucketIdKey: {
+			Description:      "HCP/Ent Only. Storage bucket for this target. Only applicable for SSH targets.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateId(storageBucketIds),
+		},
+		targetAliasKey: targetAliasSchema(),
+	}
+	if targetType != "" {
+		// the typed resources do not carry over the deprecated worker filter
+		delete(s, TypeKey)
+		delete(s, targetWorkerFilterKey)
+		for key, attr := range s {
+			if !targetTypeSupports(targetType, key) {
+				delete(s, key)
+				continue
+			}
+			attr.Description = strings.TrimSuffix(attr.Description, " Only applicable for SSH targets.")
+		}
 	}
+	return s
+}
+
+// targetTypeSupports reports whether the attribute applies to targets of the
+// given type. All attributes apply to the generic resource.
+func targetTypeSupports(targetType, key string) bool {
+	types, ok := targetTypeOnlyKeys[key]
+	return targetType == "" || !ok || slices.Contains(types, targetType)
 }
 
-func setFromTargetResponseMap(d *schema.ResourceData, raw map[string]interface{}) error {
+// resourceTargetCustomizeDiff rejects attributes that do not apply to the type
+// of the target at plan time, instead of failing during apply. The typed
+// resources only have the attributes of their type, so only the generic one
+// needs its type checked. It also plans session_max_seconds from
+// session_max_duration and host_source_ids from host_sources.
+func resourceTargetCustomizeDiff(targetType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		typeStr := targetType
+		if typeStr == "" {
+			if !d.NewValueKnown(TypeKey) {
+				return nil
+			}
+			typeStr = d.Get(TypeKey).(string)
+			if !slices.Contains(targetTypes, typeStr) {
+				return fmt.Errorf("unsupported target type %q, expected %s", typeStr, quotedList(targetTypes))
+			}
+
+			for _, key := range slices.Sorted(maps.Keys(targetTypeOnlyKeys)) {
+				types := targetTypeOnlyKeys[key]
+				if slices.Contains(types, typeStr) || !d.NewValueKnown(key) {
+					continue
+				}
+				if _, ok := d.GetOk(key); ok {
+					return fmt.Errorf("%q is only supported on %s targets, not on %s targets", key, joinOr(types), typeStr)
+				}
+			}
+		}
+
+		// Plan the seconds matching a duration string so that both are
+		// consistent after apply.
+		if d.HasChange(targetSessionMaxDurationKey) && d.NewValueKnown(targetSessionMaxDurationKey) {
+			if duration, ok := d.GetOk(targetSessionMaxDurationKey); ok {
+				seconds, err := parseDuration(duration.(string))
+				if err != nil {
+					return fmt.Errorf("invalid %q: %w", targetSessionMaxDurationKey, err)
+				}
+				if err := d.SetNew(targetSessionMaxSecondsKey, int(seconds)); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := planTargetHostSourceIds(ctx, d, meta.(*metaData)); err != nil {
+			return err
+		}
+
+		if targetTypeSupports(typeStr, targetEnableSessionRecordingKey) &&
+			d.NewValueKnown(targetEnableSessionRecordingKey) && d.NewValueKnown(targetStorageBucketIdKey) &&
+			d.Get(targetEnableSessionRecordingKey).(bool) && d.Get(targetStorageBucketIdKey).(string) == "" {
+			return fmt.Errorf("%q requires %q to be set", targetEnableSessionRecordingKey, targetStorageBucketIdKey)
+		}
+		return nil
+	}
+}
+
+// targetWorkerFilterWarnings warns when the deprecated worker filter is used
+// along with the filters replacing it. This cannot be done at plan time since
+// CustomizeDiff does not support warnings.
+func targetWorkerFilterWarnings(d *schema.ResourceData) diag.Diagnostics {
+	if filter, _ := d.Get(targetWorkerFilterKey).(string); filter == "" {
+		return nil
+	}
+	var keys []string
+	for _, key := range []string{targetWorkerEgressFilterKey, targetWorkerIngressFilterKey} {
+		if d.Get(key).(string) != "" {
+			keys = append(keys, strconv.Quote(key))
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%q is combined with %s", targetWorkerFilterKey, strings.Join(keys, " and ")),
+		Detail: fmt.Sprintf("The deprecated %q is superseded by %q and %q. Move its expression to %q and remove it.",
+			targetWorkerFilterKey, targetWorkerEgressFilterKey, targetWorkerIngressFilterKey, targetWorkerEgressFilterKey),
+	}}
+}
+
+// setFromTargetResponseMap sets the attributes of a target resource of the
+// given type, or of the generic resource if the type is empty.
+func setFromTargetResponseMap(d *schema.ResourceData, raw map[string]interface{}, targetType string) error {
+	if targetType != "" && raw["type"] != targetType {
+		return fmt.Errorf("target %q has type %q, expected %q", raw["id"], raw["type"], targetType)
+	}
 	if err := d.Set(NameKey, raw["name"]); err != nil {
 		return err
 	}
@@ -159,8 +331,10 @@ func setFromTargetResponseMap(d *schema.ResourceData, raw map[string]interface{}
 	if err := d.Set(ScopeIdKey, raw["scope_id"]); err != nil {
 		return err
 	}
-	if err := d.Set(TypeKey, raw["type"]); err != nil {
-		return err
+	if targetType == "" {
+		if err := d.Set(TypeKey, raw["type"]); err != nil {
+			return err
+		}
 	}
 	if err := d.Set(targetHostSourceIdsKey, raw["host_source_ids"]); err != nil {
 		return err
@@ -168,17 +342,33 @@ func setFromTargetResponseMap(d *schema.ResourceData, raw map[string]interface{}
 	if err := d.Set(targetBrokeredCredentialSourceIdsKey, raw["brokered_credential_source_ids"]); err != nil {
 		return err
 	}
-	if err := d.Set(targetInjectedAppCredentialSourceIdsKey, raw["injected_application_credential_source_ids"]); err != nil {
-		return err
+	if targetTypeSupports(targetType, targetInjectedAppCredentialSourceIdsKey) {
+		if err := d.Set(targetInjectedAppCredentialSourceIdsKey, raw["injected_application_credential_source_ids"]); err != nil {
+			return err
+		}
 	}
 	if err := d.Set(targetSessionMaxSecondsKey, raw["session_max_seconds"]); err != nil {
 		return err
 	}
+	// The duration string is only kept in sync when it is used, so that a
+	// change made outside of Terraform shows up in its plan.
+	if duration, _ := d.Get(targetSessionMaxDurationKey).(string); duration != "" {
+		if maxSeconds, ok := raw["session_max_seconds"].(json.Number); ok {
+			seconds, _ := maxSeconds.Int64()
+			if configured, err := parseDuration(duration); err != nil || configured != seconds {
+				if err := d.Set(targetSessionMaxDurationKey, formatSeconds(seconds)); err != nil {
+					return err
+				}
+			}
+		}
+	}
 	if err := d.Set(targetSessionConnectionLimitKey, raw["session_connection_limit"]); err != nil {
 		return err
 	}
-	if err := d.Set(targetWorkerFilterKey, raw["worker_filter"]); err != nil {
-		return err
+	if targetType == "" {
+		if err := d.Set(targetWorkerFilterKey, raw["worker_filter"]); err != nil {
+			return err
+		}
 	}
 	if err := d.Set(targetWorkerEgressFilterKey, raw["egress_worker_filter"]); err != nil {
 		return err
@@ -190,595 +380,697 @@ func setFromTargetResponseMap(d *schema.ResourceData, raw map[string]interface{}
 		return err
 	}
 
-	typeStr := raw["type"].(string)
-
-	switch typeStr {
-	case targetTypeTcp, targetTypeSsh:
-		if attrsVal, ok := raw["attributes"]; ok {
-			attrs := attrsVal.(map[string]interface{})
-			if defPort, ok := attrs[targetDefaultPortKey].(json.Number); ok {
-				defPortInt, _ := defPort.Int64()
-				if err := d.Set(targetDefaultPortKey, int(defPortInt)); err != nil {
-					return err
-				}
-			}
-			if defCliPort, ok := attrs[targetDefaultClientPortKey].(json.Number); ok {
-				defCliPortInt, _ := defCliPort.Int64()
-				if err := d.Set(targetDefaultClientPortKey, int(defCliPortInt)); err != nil {
-					return err
-				}
-			}
-
-			// SSH only features
-			if typeStr == targetTypeSsh {
-				if sessionRecordingVal, ok := attrs[targetEnableSessionRecordingKey].(bool); ok {
-					if err := d.Set(targetEnableSessionRecordingKey, sessionRecordingVal); err != nil {
-						return err
-					}
-				}
-				if targetStorageBucketIdVal, ok := attrs[targetStorageBucketIdKey]; ok {
-					if err := d.Set(targetStorageBucketIdKey, targetStorageBucketIdVal); err != nil {
-						return err
-					}
-				}
+	// The port attributes are shared by all target types, the others only
+	// apply to the types having them in their schema.
+	if attrs, ok := raw["attributes"].(map[string]interface{}); ok {
+		if defPort, ok := attrs[targetDefaultPortKey].(json.Number); ok {
+			defPortInt, _ := defPort.Int64()
+			if err := d.Set(targetDefaultPortKey, int(defPortInt)); err != nil {
+				return err
 			}
 		}
-	}
-
-	d.SetId(raw["id"].(string))
-	return nil
-}
-
-func resourceTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (errs diag.Diagnostics) {
-	md := meta.(*metaData)
-
-	var scopeId string
-	if scopeIdVal, ok := d.GetOk(ScopeIdKey); ok {
-		scopeId = scopeIdVal.(string)
-	} else {
-		return diag.Errorf("no scope ID provided")
-	}
-
-	var typeStr string
-	if typeVal, ok := d.GetOk(TypeKey); ok {
-		typeStr = typeVal.(string)
-	} else {
-		return diag.Errorf("no type provided")
-	}
-	switch typeStr {
-	case targetTypeTcp, targetTypeSsh:
-	default:
-		return diag.Errorf("invalid type provided")
-	}
-
-	var opts []targets.Option
-	nameVal, ok := d.GetOk(NameKey)
-	if ok {
-		nameStr := nameVal.(string)
-		opts = append(opts, targets.WithName(nameStr))
-	}
-
-	descVal, ok := d.GetOk(DescriptionKey)
-	if ok {
-		descStr := descVal.(string)
-		opts = append(opts, targets.WithDescription(descStr))
-	}
-
-	defaultPortVal, ok := d.GetOk(targetDefaultPortKey)
-	if ok {
-		defaultPortInt := defaultPortVal.(int)
-		if defaultPortInt <= 0 || defaultPortInt > math.MaxUint16 {
-			return diag.Errorf(`"default_port" must be a valid tcp port`)
-		}
-		switch typeStr {
-		case targetTypeTcp:
-			opts = append(opts, targets.WithTcpTargetDefaultPort(uint32(defaultPortInt)))
-		case targetTypeSsh:
-			opts = append(opts, targets.WithSshTargetDefaultPort(uint32(defaultPortInt)))
-		}
-	}
-
-	defaultClientPortVal, ok := d.GetOk(targetDefaultClientPortKey)
-	if ok {
-		defaultClientPortInt := defaultClientPortVal.(int)
-		if defaultClientPortInt <= 0 || defaultClientPortInt > math.MaxUint16 {
-			return diag.Errorf(`"default_client_port" must be a valid tcp port`)
-		}
-		switch typeStr {
-		case targetTypeTcp:
-			opts = append(opts, targets.WithTcpTargetDefaultClientPort(uint32(defaultClientPortInt)))
-		case targetTypeSsh:
-			opts = append(opts, targets.WithSshTargetDefaultClientPort(uint32(defaultClientPortInt)))
-		}
-	}
-
-	enableSessionRecordingVal, ok := d.GetOk(targetEnableSessionRecordingKey)
-	if ok {
-		enableSessionRecordingBool := enableSessionRecordingVal.(bool)
-		opts = append(opts, targets.WithSshTargetEnableSessionRecording(enableSessionRecordingBool))
-	}
-
-	storageBucketIdVal, ok := d.GetOk(targetStorageBucketIdKey)
-	if ok {
-		storageBucketIdStr := storageBucketIdVal.(string)
-		opts = append(opts, targets.WithSshTargetStorageBucketId(storageBucketIdStr))
-	}
-
-	sessionMaxSecondsVal, ok := d.GetOk(targetSessionMaxSecondsKey)
-	if ok {
-		sessionMaxSecondsInt := sessionMaxSecondsVal.(int)
-		if sessionMaxSecondsInt <= 0 {
-			return diag.Errorf(`"session_max_seconds" must be greater than zero`)
-		}
-		opts = append(opts, targets.WithSessionMaxSeconds(uint32(sessionMaxSecondsInt)))
-	}
-
-	sessionConnectionLimitVal, ok := d.GetOk(targetSessionConnectionLimitKey)
-	if ok {
-		sessionConnectionLimitInt := sessionConnectionLimitVal.(int)
-		if sessionConnectionLimitInt != -1 && sessionConnectionLimitInt <= 0 {
-			return diag.Errorf(`"session_connection_limit" must be -1 or greater than zero`)
-		}
-		opts = append(opts, targets.WithSessionConnectionLimit(int32(sessionConnectionLimitInt)))
-	}
-
-	var hostSourceIds []string
-	if hostSourceIdsVal, ok := d.GetOk(targetHostSourceIdsKey); ok {
-		list := hostSourceIdsVal.(*schema.Set).List()
-		hostSourceIds = make([]string, 0, len(list))
-		for _, i := range list {
-			hostSourceIds = append(hostSourceIds, i.(string))
+		if defCliPort, ok := attrs[targetDefaultClientPortKey].(json.Number); ok {
+			defCliPortInt, _ := defCliPort.Int64()
+			if err := d.Set(targetDefaultClientPortKey, int(defCliPortInt)); err != nil {
+				return err
+			}
 		}
-	}
-
-	var brokeredCreds []string
-	if credentialSourceIdsVal, ok := d.GetOk(targetBrokeredCredentialSourceIdsKey); ok {
-		list := credentialSourceIdsVal.(*schema.Set).List()
-		brokeredCreds = make([]string, 0, len(list))
-		for _, i := range list {
-			brokeredCreds = append(brokeredCreds, i.(string))
+		if sessionRecordingVal, ok := attrs[targetEnableSessionRecordingKey].(bool); ok && targetTypeSupports(targetType, targetEnableSessionRecordingKey) {
+			if err := d.Set(targetEnableSessionRecordingKey, sessionRecordingVal); err != nil {
+				return err
+			}
 		}
-	}
-
-	var injectedCreds []string
-	if credentialSourceIdsVal, ok := d.GetOk(targetInjectedAppCredentialSourceIdsKey); ok {
-		list := credentialSourceIdsVal.(*schema.Set).List()
-		injectedCreds = make([]string, 0, len(list))
-		for _, i := range list {
-			injectedCreds = append(injectedCreds, i.(string))
+		if targetStorageBucketIdVal, ok := attrs[targetStorageBucketIdKey]; ok && targetTypeSupports(targetType, targetStorageBucketIdKey) {
+			if err := d.Set(targetStorageBucketIdKey, targetStorageBucketIdVal); err != nil {
+				return err
+			}
 		}
 	}
 
-	workerFilterVal, ok := d.GetOk(targetWorkerFilterKey)
-	if ok {
-		workerFilterStr := workerFilterVal.(string)
-		opts = append(opts, targets.WithWorkerFilter(workerFilterStr))
-	}
-
-	workerEgressFilterVal, ok := d.GetOk(targetWorkerEgressFilterKey)
-	if ok {
-		workerEgressFilterStr := workerEgressFilterVal.(string)
-		opts = append(opts, targets.WithEgressWorkerFilter(workerEgressFilterStr))
-	}
-
-	workerIngressFilterVal, ok := d.GetOk(targetWorkerIngressFilterKey)
-	if ok {
-		workerIngressFilterStr := workerIngressFilterVal.(string)
-		opts = append(opts, targets.WithIngressWorkerFilter(workerIngressFilterStr))
-	}
-
-	addrVal, ok := d.GetOk(targetAddressKey)
-	if ok {
-		addrStr := addrVal.(string)
-		opts = append(opts, targets.WithAddress(addrStr))
-	}
-
-	tc := targets.NewClient(md.client)
-	tcr, err := tc.Create(ctx, typeStr, scopeId, opts...)
-	if err != nil {
-		return diag.Errorf("error creating target: %v", err)
-	}
-	if tcr == nil {
-		return diag.Errorf("target nil after create")
-	}
-	apiResponse := tcr.GetResponse().Map
-	defer func() {
-		if err := setFromTargetResponseMap(d, apiResponse); err != nil {
-			errs = append(errs, diag.FromErr(err)...)
-		}
-	}()
-
-	version := tcr.Item.Version
-	if hostSourceIds != nil {
-		tur, err := tc.SetHostSources(ctx, tcr.Item.Id, version, hostSourceIds)
+	// All attributes are kept, including the ones without a dedicated
+	// argument, so that newer attributes and target types are not dropped.
+	if attrs, ok := raw["attributes"]; ok {
+		encodedAttributes, err := json.Marshal(attrs)
 		if err != nil {
-			return diag.Errorf("error setting host sources on target: %v", err)
-		}
-		if tur == nil {
-			return diag.Errorf("nil target after setting host sources")
+			return err
 		}
-		apiResponse = tur.GetResponse().Map
-		version = tur.Item.Version
-	}
-
-	var credOpts []targets.Option
-	if brokeredCreds != nil {
-		credOpts = append(credOpts, targets.WithBrokeredCredentialSourceIds(brokeredCreds))
-	}
-	if injectedCreds != nil {
-		credOpts = append(credOpts, targets.WithInjectedApplicationCredentialSourceIds(injectedCreds))
-	}
-	if len(credOpts) > 0 {
-		tur, err := tc.SetCredentialSources(ctx, tcr.Item.Id, version, credOpts...)
-		if err != nil {
-			return diag.Errorf("error setting credential sources on target: %v", err)
+		if err := d.Set(AttributesJsonKey, string(encodedAttributes)); err != nil {
+			return err
 		}
-		if tur == nil {
-			return diag.Errorf("nil target after setting credential sources")
+	} else {
+		if err := d.Set(AttributesJsonKey, nil); err != nil {
+			return err
 		}
-		apiResponse = tur.GetResponse().Map
 	}
 
+	d.SetId(raw["id"].(string))
 	return nil
 }
 
-func resourceTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	md := meta.(*metaData)
-	tc := targets.NewClient(md.client)
+func resourceTargetCreate(targetType string) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) (errs diag.Diagnostics) {
+		md := meta.(*metaData)
 
-	trr, err := tc.Read(ctx, d.Id())
-	if err != nil {
-		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
-			d.SetId("")
-			return nil
+		var scopeId string
+		if scopeIdVal, ok := d.GetOk(ScopeIdKey); ok {
+			scopeId = scopeIdVal.(string)
+		} else {
+			return diag.Errorf("no scope ID provided")
 		}
-		return diag.Errorf("error reading target: %v", err)
-	}
-	if trr == nil {
-		return diag.Errorf("target nil after read")
-	}
-
-	if err := setFromTargetResponseMap(d, trr.GetResponse().Map); err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
-}
 
-func resourceTargetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	md := meta.(*metaData)
-	tc := targets.NewClient(md.client)
-
-	var opts []targets.Option
-
-	var typeStr string
-	if typeVal, ok := d.GetOk(TypeKey); ok {
-		typeStr = typeVal.(string)
-	}
-	switch typeStr {
-	case targetTypeTcp, targetTypeSsh:
-	default:
-		return diag.Errorf("invalid type provided")
-	}
+		typeStr := targetType
+		if typeStr == "" {
+			if typeVal, ok := d.GetOk(TypeKey); ok {
+				typeStr = typeVal.(string)
+			} else {
+				return diag.Errorf("no type provided")
+			}
+		}
+		switch typeStr {
+		case targetTypeTcp, targetTypeSsh:
+		default:
+			return diag.Errorf("invalid type provided")
+		}
 
-	var name *string
-	if d.HasChange(NameKey) {
-		opts = append(opts, targets.DefaultName())
+		var opts []targets.Option
 		nameVal, ok := d.GetOk(NameKey)
 		if ok {
 			nameStr := nameVal.(string)
-			name = &nameStr
 			opts = append(opts, targets.WithName(nameStr))
 		}
-	}
 
-	var desc *string
-	if d.HasChange(DescriptionKey) {
-		opts = append(opts, targets.DefaultDescription())
 		descVal, ok := d.GetOk(DescriptionKey)
 		if ok {
 			descStr := descVal.(string)
-			desc = &descStr
 			opts = append(opts, targets.WithDescription(descStr))
 		}
-	}
-
-	var enableSessionRecording *bool
-	if d.HasChange(targetEnableSessionRecordingKey) {
-		switch typeStr {
-		case targetTypeSsh:
-			opts = append(opts, targets.WithSshTargetEnableSessionRecording(false))
-			enableSessionRecordingVal, ok := d.GetOk(targetEnableSessionRecordingKey)
-			if ok {
-				enableSessionRecordingBool := enableSessionRecordingVal.(bool)
-				enableSessionRecording = &enableSessionRecordingBool
-				opts = append(opts, targets.WithSshTargetEnableSessionRecording(enableSessionRecordingBool))
-			}
-		}
-	}
 
-	var storageBucket *string
-	if d.HasChange(targetStorageBucketIdKey) {
-		switch typeStr {
-		case targetTypeSsh:
-			opts = append(opts, targets.DefaultSshTargetStorageBucketId())
-			storageBucketVal, ok := d.GetOk(targetStorageBucketIdKey)
-			if ok {
-				storageBucketStr := storageBucketVal.(string)
-				storageBucket = &storageBucketStr
-				opts = append(opts, targets.WithSshTargetStorageBucketId(storageBucketStr))
+		defaultPortVal, ok := d.GetOk(targetDefaultPortKey)
+		if ok {
+			defaultPortInt := defaultPortVal.(int)
+			if defaultPortInt <= 0 || defaultPortInt > math.MaxUint16 {
+				return diag.Errorf(`"default_port" must be a valid tcp port`)
 			}
-		}
-	}
-
-	var defaultPort *int
-	if d.HasChange(targetDefaultPortKey) {
-		switch typeStr {
-		case targetTypeTcp:
-			opts = append(opts, targets.DefaultTcpTargetDefaultPort())
-			defaultPortVal, ok := d.GetOk(targetDefaultPortKey)
-			if ok {
-				defaultPortInt := defaultPortVal.(int)
-				if defaultPortInt <= 0 || defaultPortInt > math.MaxUint16 {
-					return diag.Errorf(`"default_port" must be a valid tcp port`)
-				}
-				defaultPort = &defaultPortInt
+			switch typeStr {
+			case targetTypeTcp:
 				opts = append(opts, targets.WithTcpTargetDefaultPort(uint32(defaultPortInt)))
-			}
-
-		case targetTypeSsh:
-			opts = append(opts, targets.DefaultSshTargetDefaultPort())
-			defaultPortVal, ok := d.GetOk(targetDefaultPortKey)
-			if ok {
-				defaultPortInt := defaultPortVal.(int)
-				if defaultPortInt <= 0 || defaultPortInt > math.MaxUint16 {
-					return diag.Errorf(`"default_port" must be a valid tcp port`)
-				}
-				defaultPort = &defaultPortInt
+			case targetTypeSsh:
 				opts = append(opts, targets.WithSshTargetDefaultPort(uint32(defaultPortInt)))
 			}
 		}
-	}
 
-	var defaultClientPort *int
-	if d.HasChange(targetDefaultClientPortKey) {
-		switch typeStr {
-		case targetTypeTcp:
-			opts = append(opts, targets.DefaultTcpTargetDefaultClientPort())
-			defaultClientPortVal, ok := d.GetOk(targetDefaultClientPortKey)
-			if ok {
-				defaultClientPortInt := defaultClientPortVal.(int)
-				if defaultClientPortInt <= 0 || defaultClientPortInt > math.MaxUint16 {
-					return diag.Errorf(`"default_client_port" must be a valid tcp port`)
-				}
-				defaultClientPort = &defaultClientPortInt
-				opts = append(opts, targets.WithTcpTargetDefaultClientPort(uint32(defaultClientPortInt)))
+		defaultClientPortVal, ok := d.GetOk(targetDefaultClientPortKey)
+		if ok {
+			defaultClientPortInt := defaultClientPortVal.(int)
+			if defaultClientPortInt <= 0 || defaultClientPortInt > math.MaxUint16 {
+				return diag.Errorf(`"default_client_port" must be a valid tcp port`)
 			}
-
-		case targetTypeSsh:
-			opts = append(opts, targets.DefaultSshTargetDefaultClientPort())
-			defaultClientPortVal, ok := d.GetOk(targetDefaultClientPortKey)
-			if ok {
-				defaultClientPortInt := defaultClientPortVal.(int)
-				if defaultClientPortInt <= 0 || defaultClientPortInt > math.MaxUint16 {
-					return diag.Errorf(`"default_client_port" must be a valid tcp port`)
-				}
-				defaultClientPort = &defaultClientPortInt
+			switch typeStr {
+			case targetTypeTcp:
+				opts = append(opts, targets.WithTcpTargetDefaultClientPort(uint32(defaultClientPortInt)))
+			case targetTypeSsh:
 				opts = append(opts, targets.WithSshTargetDefaultClientPort(uint32(defaultClientPortInt)))
 			}
 		}
-	}
 
-	var sessionMaxSeconds *int
-	if d.HasChange(targetSessionMaxSecondsKey) {
-		opts = append(opts, targets.DefaultSessionMaxSeconds())
-		sessionMaxSecondsVal, ok := d.GetOk(targetSessionMaxSecondsKey)
+		enableSessionRecordingVal, ok := d.GetOk(targetEnableSessionRecordingKey)
+		if ok {
+			enableSessionRecordingBool := enableSessionRecordingVal.(bool)
+			opts = append(opts, targets.WithSshTargetEnableSessionRecording(enableSessionRecordingBool))
+		}
+
+		storageBucketIdVal, ok := d.GetOk(targetStorageBucketIdKey)
+		if ok {
+			storageBucketIdStr := storageBucketIdVal.(string)
+			opts = append(opts, targets.WithSshTargetStorageBucketId(storageBucketIdStr))
+		}
+
+		sessionMaxSecondsInt, ok, err := targetSessionMaxSeconds(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		if ok {
-			sessionMaxSecondsInt := sessionMaxSecondsVal.(int)
 			if sessionMaxSecondsInt <= 0 {
 				return diag.Errorf(`"session_max_seconds" must be greater than zero`)
 			}
-			sessionMaxSeconds = &sessionMaxSecondsInt
 			opts = append(opts, targets.WithSessionMaxSeconds(uint32(sessionMaxSecondsInt)))
 		}
-	}
 
-	var sessionConnectionLimit *int
-	if d.HasChange(targetSessionConnectionLimitKey) {
-		opts = append(opts, targets.DefaultSessionConnectionLimit())
 		sessionConnectionLimitVal, ok := d.GetOk(targetSessionConnectionLimitKey)
 		if ok {
 			sessionConnectionLimitInt := sessionConnectionLimitVal.(int)
 			if sessionConnectionLimitInt != -1 && sessionConnectionLimitInt <= 0 {
 				return diag.Errorf(`"session_connection_limit" must be -1 or greater than zero`)
 			}
-			sessionConnectionLimit = &sessionConnectionLimitInt
 			opts = append(opts, targets.WithSessionConnectionLimit(int32(sessionConnectionLimitInt)))
 		}
-	}
 
-	var workerFilter *string
-	if d.HasChange(targetWorkerFilterKey) {
-		opts = append(opts, targets.DefaultWorkerFilter())
+		hostSourceIds, _, err := targetHostSourceIds(ctx, d, md.client)
+		if err != nil {
+			return diag.Errorf("error resolving host sources: %v", err)
+		}
+
+		var brokeredCreds []string
+		if credentialSourceIdsVal, ok := d.GetOk(targetBrokeredCredentialSourceIdsKey); ok {
+			list := credentialSourceIdsVal.(*schema.Set).List()
+			brokeredCreds = make([]string, 0, len(list))
+			for _, i := range list {
+				brokeredCreds = append(brokeredCreds, i.(string))
+			}
+		}
+
+		var injectedCreds []string
+		if credentialSourceIdsVal, ok := d.GetOk(targetInjectedAppCredentialSourceIdsKey); ok {
+			list := credentialSourceIdsVal.(*schema.Set).List()
+			injectedCreds = make([]string, 0, len(list))
+			for _, i := range list {
+				injectedCreds = append(injectedCreds, i.(string))
+			}
+		}
+
 		workerFilterVal, ok := d.GetOk(targetWorkerFilterKey)
 		if ok {
 			workerFilterStr := workerFilterVal.(string)
-			workerFilter = &workerFilterStr
 			opts = append(opts, targets.WithWorkerFilter(workerFilterStr))
 		}
-	}
 
-	var workerEgressFilter *string
-	if d.HasChange(targetWorkerEgressFilterKey) {
-		opts = append(opts, targets.DefaultEgressWorkerFilter())
 		workerEgressFilterVal, ok := d.GetOk(targetWorkerEgressFilterKey)
 		if ok {
 			workerEgressFilterStr := workerEgressFilterVal.(string)
-			workerEgressFilter = &workerEgressFilterStr
 			opts = append(opts, targets.WithEgressWorkerFilter(workerEgressFilterStr))
 		}
-	}
 
-	var workerIngressFilter *string
-	if d.HasChange(targetWorkerIngressFilterKey) {
-		opts = append(opts, targets.DefaultIngressWorkerFilter())
 		workerIngressFilterVal, ok := d.GetOk(targetWorkerIngressFilterKey)
 		if ok {
 			workerIngressFilterStr := workerIngressFilterVal.(string)
-			workerIngressFilter = &workerIngressFilterStr
 			opts = append(opts, targets.WithIngressWorkerFilter(workerIngressFilterStr))
 		}
-	}
 
-	var addr *string
-	if d.HasChange(targetAddressKey) {
-		opts = append(opts, targets.DefaultAddress())
 		addrVal, ok := d.GetOk(targetAddressKey)
 		if ok {
 			addrStr := addrVal.(string)
-			addr = &addrStr
 			opts = append(opts, targets.WithAddress(addrStr))
 		}
-	}
 
-	if len(opts) > 0 {
-		opts = append(opts, targets.WithAutomaticVersioning(true))
-		_, err := tc.Update(ctx, d.Id(), 0, opts...)
-		if err != nil {
-			return diag.Errorf("error updating target: %v", err)
+		if aliasOpt, ok := targetAliasCreateOption(d); ok {
+			opts = append(opts, aliasOpt)
 		}
-	}
 
-	if d.HasChange(NameKey) {
-		if err := d.Set(NameKey, name); err != nil {
-			return diag.FromErr(err)
+		tc := targets.NewClient(md.client)
+		tcr, err := tc.Create(ctx, typeStr, scopeId, opts...)
+		if err != nil {
+			return diag.Errorf("error creating target: %v", err)
 		}
-	}
-	if d.HasChange(DescriptionKey) {
-		if err := d.Set(DescriptionKey, desc); err != nil {
-			return diag.FromErr(err)
+		if tcr == nil {
+			return diag.Errorf("target nil after create")
 		}
-	}
-	if d.HasChange(targetDefaultPortKey) {
-		if err := d.Set(targetDefaultPortKey, defaultPort); err != nil {
+		if err := setTargetAliasesFromCreate(d, tcr.Item.Aliases); err != nil {
 			return diag.FromErr(err)
 		}
-	}
-	if d.HasChange(targetDefaultClientPortKey) {
-		if err := d.Set(targetDefaultClientPortKey, defaultClientPort); err != nil {
-			return diag.FromErr(err)
+		apiResponse := tcr.GetResponse().Map
+		defer func() {
+			if err := setFromTargetResponseMap(d, apiResponse, targetType); err != nil {
+				errs = append(errs, diag.FromErr(err)...)
+			}
+		}()
+
+		version := tcr.Item.Version
+		if hostSourceIds != nil {
+			tur, err := tc.SetHostSources(ctx, tcr.Item.Id, version, hostSourceIds)
+			if err != nil {
+				return diag.Errorf("error setting host sources on target: %v", err)
+			}
+			if tur == nil {
+				return diag.Errorf("nil target after setting host sources")
+			}
+			apiResponse = tur.GetResponse().Map
+			version = tur.Item.Version
 		}
-	}
-	if d.HasChange(targetSessionMaxSecondsKey) {
-		if err := d.Set(targetSessionMaxSecondsKey, sessionMaxSeconds); err != nil {
-			return diag.FromErr(err)
+
+		var credOpts []targets.Option
+		if brokeredCreds != nil {
+			credOpts = append(credOpts, targets.WithBrokeredCredentialSourceIds(brokeredCreds))
 		}
-	}
-	if d.HasChange(targetSessionConnectionLimitKey) {
-		if err := d.Set(targetSessionConnectionLimitKey, sessionConnectionLimit); err != nil {
-			return diag.FromErr(err)
+		if injectedCreds != nil {
+			credOpts = append(credOpts, targets.WithInjectedApplicationCredentialSourceIds(injectedCreds))
 		}
-	}
-	if d.HasChange(targetWorkerFilterKey) {
-		if err := d.Set(targetWorkerFilterKey, workerFilter); err != nil {
-			return diag.FromErr(err)
+		if len(credOpts) > 0 {
+			tur, err := tc.SetCredentialSources(ctx, tcr.Item.Id, version, credOpts...)
+			if err != nil {
+				return diag.Errorf("error setting credential sources on target: %v", err)
+			}
+			if tur == nil {
+				return diag.Errorf("nil target after setting credential sources")
+			}
+			apiResponse = tur.GetResponse().Map
 		}
+
+		return targetWorkerFilterWarnings(d)
 	}
-	if d.HasChange(targetWorkerEgressFilterKey) {
-		if err := d.Set(targetWorkerEgressFilterKey, workerEgressFilter); err != nil {
-			return diag.FromErr(err)
+}
+
+func resourceTargetRead(targetType string) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		md := meta.(*metaData)
+		tc := targets.NewClient(md.client)
+
+		trr, err := tc.Read(ctx, d.Id())
+		if err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+			return diag.Errorf("error reading target: %v", err)
 		}
-	}
-	if d.HasChange(targetWorkerIngressFilterKey) {
-		if err := d.Set(targetWorkerIngressFilterKey, workerIngressFilter); err != nil {
-			return diag.FromErr(err)
+		if trr == nil {
+			return diag.Errorf("target nil after read")
 		}
-	}
-	if d.HasChange(targetAddressKey) {
-		if err := d.Set(targetAddressKey, addr); err != nil {
+
+		if err := setFromTargetResponseMap(d, trr.GetResponse().Map, targetType); err != nil {
 			return diag.FromErr(err)
 		}
-	}
-	if d.HasChange(targetEnableSessionRecordingKey) {
-		if err := d.Set(targetEnableSessionRecordingKey, enableSessionRecording); err != nil {
+		if err := readTargetAliases(ctx, md.client, d); err != nil {
 			return diag.FromErr(err)
 		}
+
+		return nil
 	}
-	if d.HasChange(targetStorageBucketIdKey) {
-		if err := d.Set(targetStorageBucketIdKey, storageBucket); err != nil {
-			return diag.FromErr(err)
+}
+
+func resourceTargetUpdate(targetType string) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		md := meta.(*metaData)
+		tc := targets.NewClient(md.client)
+
+		var opts []targets.Option
+
+		typeStr := targetType
+		if typeStr == "" {
+			typeStr = d.Get(TypeKey).(string)
+		}
+		switch typeStr {
+		case targetTypeTcp, targetTypeSsh:
+		default:
+			return diag.Errorf("invalid type provided")
 		}
-	}
 
-	// The above call may not actually happen, so we use d.Id() and automatic
-	// versioning here
-	if d.HasChange(targetHostSourceIdsKey) {
-		var hostSourceIds []string
-		if hostSourceIdsVal, ok := d.GetOk(targetHostSourceIdsKey); ok {
-			hostSources := hostSourceIdsVal.(*schema.Set).List()
-			for _, hostSource := range hostSources {
-				hostSourceIds = append(hostSourceIds, hostSource.(string))
+		var name *string
+		if d.HasChange(NameKey) {
+			opts = append(opts, targets.DefaultName())
+			nameVal, ok := d.GetOk(NameKey)
+			if ok {
+				nameStr := nameVal.(string)
+				name = &nameStr
+				opts = append(opts, targets.WithName(nameStr))
 			}
 		}
-		_, err := tc.SetHostSources(ctx, d.Id(), 0, hostSourceIds, targets.WithAutomaticVersioning(true))
-		if err != nil {
-			return diag.Errorf("error updating host sources in target: %v", err)
+
+		var desc *string
+		if d.HasChange(DescriptionKey) {
+			opts = append(opts, targets.DefaultDescription())
+			descVal, ok := d.GetOk(DescriptionKey)
+			if ok {
+				descStr := descVal.(string)
+				desc = &descStr
+				opts = append(opts, targets.WithDescription(descStr))
+			}
 		}
-		if err := d.Set(targetHostSourceIdsKey, hostSourceIds); err != nil {
-			return diag.FromErr(err)
+
+		var enableSessionRecording *bool
+		if d.HasChange(targetEnableSessionRecordingKey) {
+			switch typeStr {
+			case targetTypeSsh:
+				opts = append(opts, targets.WithSshTargetEnableSessionRecording(false))
+				enableSessionRecordingVal, ok := d.GetOk(targetEnableSessionRecordingKey)
+				if ok {
+					enableSessionRecordingBool := enableSessionRecordingVal.(bool)
+					enableSessionRecording = &enableSessionRecordingBool
+					opts = append(opts, targets.WithSshTargetEnableSessionRecording(enableSessionRecordingBool))
+				}
+			}
 		}
-	}
 
-	// if any of the credential types are changed, then all credential ids must be gathered
-	// because the SetCredentialSources function will remove ids that are not present.
-	if d.HasChange(targetBrokeredCredentialSourceIdsKey) || d.HasChange(targetInjectedAppCredentialSourceIdsKey) {
-		credOpts := []targets.Option{
-			targets.WithAutomaticVersioning(true),
+		var storageBucket *string
+		if d.HasChange(targetStorageBucketIdKey) {
+			switch typeStr {
+			case targetTypeSsh:
+				opts = append(opts, targets.DefaultSshTargetStorageBucketId())
+				storageBucketVal, ok := d.GetOk(targetStorageBucketIdKey)
+				if ok {
+					storageBucketStr := storageBucketVal.(string)
+					storageBucket = &storageBucketStr
+					opts = append(opts, targets.WithSshTargetStorageBucketId(storageBucketStr))
+				}
+			}
 		}
 
-		if credentialSourceIdsVal, ok := d.GetOk(targetBrokeredCredentialSourceIdsKey); ok {
-			var credentialSourceIds []string
-			credSourceIds := credentialSourceIdsVal.(*schema.Set).List()
-			for _, credSourceId := range credSourceIds {
-				credentialSourceIds = append(credentialSourceIds, credSourceId.(string))
+		var defaultPort *int
+		if d.HasChange(targetDefaultPortKey) {
+			switch typeStr {
+			case targetTypeTcp:
+				opts = append(opts, targets.DefaultTcpTargetDefaultPort())
+				defaultPortVal, ok := d.GetOk(targetDefaultPortKey)
+				if ok {
+					defaultPortInt := defaultPortVal.(int)
+					if defaultPortInt <= 0 || defaultPortInt > math.MaxUint16 {
+						return diag.Errorf(`"default_port" must be a valid tcp port`)
+					}
+					defaultPort = &defaultPortInt
+					opts = append(opts, targets.WithTcpTargetDefaultPort(uint32(defaultPortInt)))
+				}
+
+			case targetTypeSsh:
+				opts = append(opts, targets.DefaultSshTargetDefaultPort())
+				defaultPortVal, ok := d.GetOk(targetDefaultPortKey)
+				if ok {
+					defaultPortInt := defaultPortVal.(int)
+					if defaultPortInt <= 0 || defaultPortInt > math.MaxUint16 {
+						return diag.Errorf(`"default_port" must be a valid tcp port`)
+					}
+					defaultPort = &defaultPortInt
+					opts = append(opts, targets.WithSshTargetDefaultPort(uint32(defaultPortInt)))
+				}
 			}
-			credOpts = append(credOpts, targets.WithBrokeredCredentialSourceIds(credentialSourceIds))
 		}
 
-		if credentialSourceIdsVal, ok := d.GetOk(targetInjectedAppCredentialSourceIdsKey); ok {
-			var credentialSourceIds []string
-			credSourceIds := credentialSourceIdsVal.(*schema.Set).List()
-			for _, credSourceId := range credSourceIds {
-				credentialSourceIds = append(credentialSourceIds, credSourceId.(string))
+		var defaultClientPort *int
+		if d.HasChange(targetDefaultClientPortKey) {
+			switch typeStr {
+			case targetTypeTcp:
+				opts = append(opts, targets.DefaultTcpTargetDefaultClientPort())
+				defaultClientPortVal, ok := d.GetOk(targetDefaultClientPortKey)
+				if ok {
+					defaultClientPortInt := defaultClientPortVal.(int)
+					if defaultClientPortInt <= 0 || defaultClientPortInt > math.MaxUint16 {
+						return diag.Errorf(`"default_client_port" must be a valid tcp port`)
+					}
+					defaultClientPort = &defaultClientPortInt
+					opts = append(opts, targets.WithTcpTargetDefaultClientPort(uint32(defaultClientPortInt)))
+				}
+
+			case targetTypeSsh:
+				opts = append(opts, targets.DefaultSshTargetDefaultClientPort())
+				defaultClientPortVal, ok := d.GetOk(targetDefaultClientPortKey)
+				if ok {
+					defaultClientPortInt := defaultClientPortVal.(int)
+					if defaultClientPortInt <= 0 || defaultClientPortInt > math.MaxUint16 {
+						return diag.Errorf(`"default_client_port" must be a valid tcp port`)
+					}
+					defaultClientPort = &defaultClientPortInt
+					opts = append(opts, targets.WithSshTargetDefaultClientPort(uint32(defaultClientPortInt)))
+				}
 			}
-			credOpts = append(credOpts, targets.WithInjectedApplicationCredentialSourceIds(credentialSourceIds))
 		}
 
-		result, err := tc.SetCredentialSources(ctx, d.Id(), 0, credOpts...)
-		if err != nil {
-			return diag.Errorf("error updating credential sources in target: %v", err)
+		var sessionMaxSeconds *int
+		if d.HasChange(targetSessionMaxSecondsKey) || d.HasChange(targetSessionMaxDurationKey) {
+			opts = append(opts, targets.DefaultSessionMaxSeconds())
+			sessionMaxSecondsInt, ok, err := targetSessionMaxSeconds(d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if ok {
+				if sessionMaxSecondsInt <= 0 {
+					return diag.Errorf(`"session_max_seconds" must be greater than zero`)
+				}
+				sessionMaxSeconds = &sessionMaxSecondsInt
+				opts = append(opts, targets.WithSessionMaxSeconds(uint32(sessionMaxSecondsInt)))
+			}
 		}
 
-		if d.HasChange(targetBrokeredCredentialSourceIdsKey) {
-			if err := d.Set(targetBrokeredCredentialSourceIdsKey, result.Item.BrokeredCredentialSourceIds); err != nil {
+		var sessionConnectionLimit *int
+		if d.HasChange(targetSessionConnectionLimitKey) {
+			opts = append(opts, targets.DefaultSessionConnectionLimit())
+			sessionConnectionLimitVal, ok := d.GetOk(targetSessionConnectionLimitKey)
+			if ok {
+				sessionConnectionLimitInt := sessionConnectionLimitVal.(int)
+				if sessionConnectionLimitInt != -1 && sessionConnectionLimitInt <= 0 {
+					return diag.Errorf(`"session_connection_limit" must be -1 or greater than zero`)
+				}
+				sessionConnectionLimit = &sessionConnectionLimitInt
+				opts = append(opts, targets.WithSessionConnectionLimit(int32(sessionConnectionLimitInt)))
+			}
+		}
+
+		var workerFilter *string
+		if d.HasChange(targetWorkerFilterKey) {
+			opts = append(opts, targets.DefaultWorkerFilter())
+			workerFilterVal, ok := d.GetOk(targetWorkerFilterKey)
+			if ok {
+				workerFilterStr := workerFilterVal.(string)
+				workerFilter = &workerFilterStr
+				opts = append(opts, targets.WithWorkerFilter(workerFilterStr))
+			}
+		}
+
+		var workerEgressFilter *string
+		if d.HasChange(targetWorkerEgressFilterKey) {
+			opts = append(opts, targets.DefaultEgressWorkerFilter())
+			workerEgressFilterVal, ok := d.GetOk(targetWorkerEgressFilterKey)
+			if ok {
+				workerEgressFilterStr := workerEgressFilterVal.(string)
+				workerEgressFilter = &workerEgressFilterStr
+				opts = append(opts, targets.WithEgressWorkerFilter(workerEgressFilterStr))
+			}
+		}
+
+		var workerIngressFilter *string
+		if d.HasChange(targetWorkerIngressFilterKey) {
+			opts = append(opts, targets.DefaultIngressWorkerFilter())
+			workerIngressFilterVal, ok := d.GetOk(targetWorkerIngressFilterKey)
+			if ok {
+				workerIngressFilterStr := workerIngressFilterVal.(string)
+				workerIngressFilter = &workerIngressFilterStr
+				opts = append(opts, targets.WithIngressWorkerFilter(workerIngressFilterStr))
+			}
+		}
+
+		var addr *string
+		if d.HasChange(targetAddressKey) {
+			opts = append(opts, targets.DefaultAddress())
+			addrVal, ok := d.GetOk(targetAddressKey)
+			if ok {
+				addrStr := addrVal.(string)
+				addr = &addrStr
+				opts = append(opts, targets.WithAddress(addrStr))
+			}
+		}
+
+		if len(opts) > 0 {
+			opts = append(opts, targets.WithAutomaticVersioning(true))
+			_, err := tc.Update(ctx, d.Id(), 0, opts...)
+			if err != nil {
+				return diag.Errorf("error updating target: %v", err)
+			}
+		}
+
+		if d.HasChange(NameKey) {
+			if err := d.Set(NameKey, name); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange(DescriptionKey) {
+			if err := d.Set(DescriptionKey, desc); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange(targetDefaultPortKey) {
+			if err := d.Set(targetDefaultPortKey, defaultPort); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange(targetDefaultClientPortKey) {
+			if err := d.Set(targetDefaultClientPortKey, defaultClientPort); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange(targetSessionMaxSecondsKey) || d.HasChange(targetSessionMaxDurationKey) {
+			if err := d.Set(targetSessionMaxSecondsKey, sessionMaxSeconds); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange(targetSessionConnectionLimitKey) {
+			if err := d.Set(targetSessionConnectionLimitKey, sessionConnectionLimit); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange(targetWorkerFilterKey) {
+			if err := d.Set(targetWorkerFilterKey, workerFilter); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange(targetWorkerEgressFilterKey) {
+			if err := d.Set(targetWorkerEgressFilterKey, workerEgressFilter); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange(targetWorkerIngressFilterKey) {
+			if err := d.Set(targetWorkerIngressFilterKey, workerIngressFilter); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange(targetAddressKey) {
+			if err := d.Set(targetAddressKey, addr); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange(targetEnableSessionRecordingKey) {
+			if err := d.Set(targetEnableSessionRecordingKey, enableSessionRecording); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange(targetStorageBucketIdKey) {
+			if err := d.Set(targetStorageBucketIdKey, storageBucket); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		// Host and credential sources may also be attached to the target by
+		// boundary_target_host_source and boundary_target_credential_source, so
+		// only the sources that changed are added or removed instead of replacing
+		// the full set. The above call may not actually happen, so we use d.Id()
+		// and automatic versioning here.
+		if d.HasChange(targetHostSourceIdsKey) || d.HasChange(targetBrokeredCredentialSourceIdsKey) || d.HasChange(targetInjectedAppCredentialSourceIdsKey) {
+			resourceMutexKV.Lock(d.Id())
+			defer resourceMutexKV.Unlock(d.Id())
+		}
+
+		if d.HasChange(targetHostSourceIdsKey) {
+			hostSourceIds, _, err := targetHostSourceIds(ctx, d, md.client)
+			if err != nil {
+				return diag.Errorf("error resolving host sources: %v", err)
+			}
+			o, _ := d.GetChange(targetHostSourceIdsKey)
+			oldSet := o.(*schema.Set)
+			newSet := schema.NewSet(schema.HashString, nil)
+			for _, id := range hostSourceIds {
+				newSet.Add(id)
+			}
+			var removed, added []string
+			for _, v := range oldSet.Difference(newSet).List() {
+				removed = append(removed, v.(string))
+			}
+			for _, v := range newSet.Difference(oldSet).List() {
+				added = append(added, v.(string))
+			}
+			if len(removed) > 0 {
+				_, err := tc.RemoveHostSources(ctx, d.Id(), 0, removed, targets.WithAutomaticVersioning(true))
+				if err != nil {
+					return diag.Errorf("error removing host sources from target: %v", err)
+				}
+			}
+			if len(added) > 0 {
+				_, err := tc.AddHostSources(ctx, d.Id(), 0, added, targets.WithAutomaticVersioning(true))
+				if err != nil {
+					return diag.Errorf("error adding host sources to target: %v", err)
+				}
+			}
+			if err := d.Set(targetHostSourceIdsKey, hostSourceIds); err != nil {
 				return diag.FromErr(err)
 			}
 		}
 
-		if d.HasChange(targetInjectedAppCredentialSourceIdsKey) {
-			if err := d.Set(targetInjectedAppCredentialSourceIdsKey, result.Item.InjectedApplicationCredentialSourceIds); err != nil {
+		if d.HasChange(targetBrokeredCredentialSourceIdsKey) || d.HasChange(targetInjectedAppCredentialSourceIdsKey) {
+			brokeredRemoved, brokeredAdded := setChanges(d, targetBrokeredCredentialSourceIdsKey)
+			injectedRemoved, injectedAdded := setChanges(d, targetInjectedAppCredentialSourceIdsKey)
+
+			var result *targets.TargetUpdateResult
+			if len(brokeredRemoved) > 0 || len(injectedRemoved) > 0 {
+				credOpts := []targets.Option{
+					targets.WithAutomaticVersioning(true),
+				}
+				if len(brokeredRemoved) > 0 {
+					credOpts = append(credOpts, targets.WithBrokeredCredentialSourceIds(brokeredRemoved))
+				}
+				if len(injectedRemoved) > 0 {
+					credOpts = append(credOpts, targets.WithInjectedApplicationCredentialSourceIds(injectedRemoved))
+				}
+				var err error
+				result, err = tc.RemoveCredentialSources(ctx, d.Id(), 0, credOpts...)
+				if err != nil {
+					return diag.Errorf("error removing credential sources from target: %v", err)
+				}
+			}
+			if len(brokeredAdded) > 0 || len(injectedAdded) > 0 {
+				credOpts := []targets.Option{
+					targets.WithAutomaticVersioning(true),
+				}
+				if len(brokeredAdded) > 0 {
+					credOpts = append(credOpts, targets.WithBrokeredCredentialSourceIds(brokeredAdded))
+				}
+				if len(injectedAdded) > 0 {
+					credOpts = append(credOpts, targets.WithInjectedApplicationCredentialSourceIds(injectedAdded))
+				}
+				var err error
+				result, err = tc.AddCredentialSources(ctx, d.Id(), 0, credOpts...)
+				if err != nil {
+					return diag.Errorf("error adding credential sources to target: %v", err)
+				}
+			}
+
+			if result != nil {
+				if d.HasChange(targetBrokeredCredentialSourceIdsKey) {
+					if err := d.Set(targetBrokeredCredentialSourceIdsKey, result.Item.BrokeredCredentialSourceIds); err != nil {
+						return diag.FromErr(err)
+					}
+				}
+
+				if d.HasChange(targetInjectedAppCredentialSourceIdsKey) {
+					if err := d.Set(targetInjectedAppCredentialSourceIdsKey, result.Item.InjectedApplicationCredentialSourceIds); err != nil {
+						return diag.FromErr(err)
+					}
+				}
+			}
+		}
+
+		if d.HasChange(targetAliasKey) {
+			if err := updateTargetAliases(ctx, md.client, d); err != nil {
 				return diag.FromErr(err)
 			}
 		}
+
+		return targetWorkerFilterWarnings(d)
+	}
+}
+
+// targetSessionMaxSeconds returns the maximum session duration configured with
+// either session_max_seconds or session_max_duration.
+func targetSessionMaxSeconds(d *schema.ResourceData) (int, bool, error) {
+	if v, ok := d.GetOk(targetSessionMaxDurationKey); ok {
+		seconds, err := parseDuration(v.(string))
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid %q: %w", targetSessionMaxDurationKey, err)
+		}
+		return int(seconds), true, nil
+	}
+	if v, ok := d.GetOk(targetSessionMaxSecondsKey); ok {
+		return v.(int), true, nil
 	}
+	return 0, false, nil
+}
 
-	return nil
+// setChanges returns the string elements removed from and added to the set
+// attribute with the given key.
+func setChanges(d *schema.ResourceData, key string) (removed, added []string) {
+	o, n := d.GetChange(key)
+	oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+	for _, v := range oldSet.Difference(newSet).List() {
+		removed = append(removed, v.(string))
+	}
+	for _, v := range newSet.Difference(oldSet).List() {
+		added = append(added, v.(string))
+	}
+	return removed, added
 }
 
 func resourceTargetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	md := meta.(*metaData)
 	tc := targets.NewClient(md.client)
 
+	if err := deleteTargetAliases(ctx, md.client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	_, err := tc.Delete(ctx, d.Id())
 	if err != nil {
 		return diag.Errorf("error deleting target: %s", err.Error())
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	targetCredentialSourceIdKey      = "credential_source_id"
+	targetCredentialSourcePurposeKey = "purpose"
+
+	credentialPurposeBrokered            = "brokered"
+	credentialPurposeInjectedApplication = "injected_application"
+)
+
+func resourceTargetCredentialSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "The target credential source resource allows you to add a single credential source to a Boundary target " +
+			"without managing the full set of credential sources on that target. The `boundary_target` resource must omit " +
+			"the `brokered_credential_source_ids` or `injected_application_credential_source_ids` attribute matching the " +
+			"purpose and list it in `ignore_changes`, otherwise both resources will fight over the credential sources.",
+
+		CreateContext: resourceTargetCredentialSourceCreate,
+		ReadContext:   resourceTargetCredentialSourceRead,
+		DeleteContext: resourceTargetCredentialSourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTargetCredentialSourceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the target credential source, in the form `<target_id>:<purpose>:<credential_source_id>`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			targetIdKey: {
+				Description:      "The ID of the target to add the credential source to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(targetIds),
+			},
+			targetCredentialSourceIdKey: {
+				Description:      "The ID of the credential source (credential library or credential) to add to the target.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(credentialSourceIds...),
+			},
+			targetCredentialSourcePurposeKey: {
+				Description: "The purpose of the credential source on the target, either `brokered` or `injected_application`. " +
+					"Injected application credentials are only supported on SSH targets.",
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					credentialPurposeBrokered,
+					credentialPurposeInjectedApplication,
+				}, false),
+			},
+		},
+	}
+}
+
+// credentialSourceOption returns the option used to add or remove the given
+// credential source IDs for the given purpose.
+func credentialSourceOption(purpose string, credentialSourceIds []string) (targets.Option, error) {
+	switch purpose {
+	case credentialPurposeBrokered:
+		return targets.WithBrokeredCredentialSourceIds(credentialSourceIds), nil
+	case credentialPurposeInjectedApplication:
+		return targets.WithInjectedApplicationCredentialSourceIds(credentialSourceIds), nil
+	default:
+		return nil, fmt.Errorf("unknown credential purpose %q", purpose)
+	}
+}
+
+func resourceTargetCredentialSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(targetIdKey).(string)
+	credentialSourceId := d.Get(targetCredentialSourceIdKey).(string)
+	purpose := d.Get(targetCredentialSourcePurposeKey).(string)
+
+	credOpt, err := credentialSourceOption(purpose, []string{credentialSourceId})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceMutexKV.Lock(targetId)
+	defer resourceMutexKV.Unlock(targetId)
+
+	_, err = tc.AddCredentialSources(ctx, targetId, 0, credOpt, targets.WithAutomaticVersioning(true))
+	if err != nil {
+		return diag.Errorf("error adding credential source to target: %v", err)
+	}
+
+	d.SetId(attachmentId(targetId, purpose, credentialSourceId))
+
+	return nil
+}
+
+func resourceTargetCredentialSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(targetIdKey).(string)
+	credentialSourceId := d.Get(targetCredentialSourceIdKey).(string)
+	purpose := d.Get(targetCredentialSourcePurposeKey).(string)
+
+	trr, err := tc.Read(ctx, targetId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			// the target is gone, destroy this resource
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading target: %v", err)
+	}
+	if trr == nil {
+		return diag.Errorf("target nil after read")
+	}
+
+	var credentialSourceIds []string
+	switch purpose {
+	case credentialPurposeBrokered:
+		credentialSourceIds = trr.GetItem().BrokeredCredentialSourceIds
+	case credentialPurposeInjectedApplication:
+		credentialSourceIds = trr.GetItem().InjectedApplicationCredentialSourceIds
+	default:
+		return diag.Errorf("unknown credential purpose %q", purpose)
+	}
+
+	for _, id := range credentialSourceIds {
+		if id == credentialSourceId {
+			return nil
+		}
+	}
+
+	// this credential source is no longer on the target, destroy this resource
+	d.SetId("")
+	return nil
+}
+
+func resourceTargetCredentialSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(targetIdKey).(string)
+	credentialSourceId := d.Get(targetCredentialSourceIdKey).(string)
+	purpose := d.Get(targetCredentialSourcePurposeKey).(string)
+
+	credOpt, err := credentialSourceOption(purpose, []string{credentialSourceId})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceMutexKV.Lock(targetId)
+	defer resourceMutexKV.Unlock(targetId)
+
+	_, err = tc.RemoveCredentialSources(ctx, targetId, 0, credOpt, targets.WithAutomaticVersioning(true))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error removing credential source from target: %v", err)
+	}
+
+	return nil
+}
+
+func resourceTargetCredentialSourceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitAttachmentId(d.Id(), 3)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := credentialSourceOption(parts[1], nil); err != nil {
+		return nil, err
+	}
+	if err := d.Set(targetIdKey, parts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set(targetCredentialSourcePurposeKey, parts[1]); err != nil {
+		return nil, err
+	}
+	if err := d.Set(targetCredentialSourceIdKey, parts[2]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/boundary/testing/vault"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	targetCredentialSourceFoo = `
+resource "boundary_target_credential_source" "foo" {
+	target_id            = boundary_target.foo.id
+	credential_source_id = boundary_credential_library_vault.foo.id
+	purpose              = "brokered"
+}`
+
+	targetCredentialSourceBar = `
+resource "boundary_target_credential_source" "bar" {
+	target_id            = boundary_target.foo.id
+	credential_source_id = boundary_credential_library_vault.bar.id
+	purpose              = "brokered"
+}`
+
+	targetCredentialSourceInvalidPurpose = `
+resource "boundary_target_credential_source" "foo" {
+	target_id            = "ttcp_1234567890"
+	credential_source_id = "clvlt_1234567890"
+	purpose              = "egress"
+}`
+)
+
+func TestAccTargetCredentialSource(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+	url := tc.ApiAddrs()[0]
+
+	vc := vault.NewTestVaultServer(t)
+	_, token := vc.CreateToken(t)
+	credStoreRes := vaultCredStoreResource(vc,
+		vaultCredStoreName,
+		vaultCredStoreDesc,
+		vaultCredStoreNamespace,
+		"www.original.com",
+		token,
+		true)
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// test create
+				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, fooBarCredLibs, fooTargetWithoutSources, targetCredentialSourceFoo),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.foo"),
+					testAccCheckTargetResourceBrokeredCredSources(provider, "boundary_target.foo", []string{"boundary_credential_library_vault.foo"}),
+				),
+			},
+			importStep("boundary_target_credential_source.foo"),
+			{
+				// add a second credential source, the first one must be left untouched
+				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, fooBarCredLibs, fooTargetWithoutSources, targetCredentialSourceFoo, targetCredentialSourceBar),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceBrokeredCredSources(provider, "boundary_target.foo", []string{"boundary_credential_library_vault.foo", "boundary_credential_library_vault.bar"}),
+				),
+			},
+			importStep("boundary_target_credential_source.bar"),
+			{
+				// remove the first credential source
+				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, fooBarCredLibs, fooTargetWithoutSources, targetCredentialSourceBar),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceBrokeredCredSources(provider, "boundary_target.foo", []string{"boundary_credential_library_vault.bar"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTargetCredentialSource_InvalidPurpose(t *testing.T) {
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfig("not_required", targetCredentialSourceInvalidPurpose),
+				ExpectError: regexp.MustCompile(`expected purpose to be one of`),
+			},
+		},
+	})
+}
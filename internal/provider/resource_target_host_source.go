@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	targetIdKey           = "target_id"
+	targetHostSourceIdKey = "host_source_id"
+)
+
+func resourceTargetHostSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "The target host source resource allows you to add a single host source to a Boundary target " +
+			"without managing the full set of host sources on that target. The `boundary_target` resource must omit " +
+			"`host_source_ids` and list it in `ignore_changes`, otherwise both resources will fight over the host sources.",
+
+		CreateContext: resourceTargetHostSourceCreate,
+		ReadContext:   resourceTargetHostSourceRead,
+		DeleteContext: resourceTargetHostSourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTargetHostSourceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the target host source, in the form `<target_id>:<host_source_id>`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			targetIdKey: {
+				Description:      "The ID of the target to add the host source to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(targetIds),
+			},
+			targetHostSourceIdKey: {
+				Description:      "The ID of the host source (host set) to add to the target.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(hostSetIds...),
+			},
+		},
+	}
+}
+
+func resourceTargetHostSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(targetIdKey).(string)
+	hostSourceId := d.Get(targetHostSourceIdKey).(string)
+
+	resourceMutexKV.Lock(targetId)
+	defer resourceMutexKV.Unlock(targetId)
+
+	_, err := tc.AddHostSources(ctx, targetId, 0, []string{hostSourceId}, targets.WithAutomaticVersioning(true))
+	if err != nil {
+		return diag.Errorf("error adding host source to target: %v", err)
+	}
+
+	d.SetId(attachmentId(targetId, hostSourceId))
+
+	return nil
+}
+
+func resourceTargetHostSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(targetIdKey).(string)
+	hostSourceId := d.Get(targetHostSourceIdKey).(string)
+
+	trr, err := tc.Read(ctx, targetId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			// the target is gone, destroy this resource
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading target: %v", err)
+	}
+	if trr == nil {
+		return diag.Errorf("target nil after read")
+	}
+
+	for _, id := range trr.GetItem().HostSourceIds {
+		if id == hostSourceId {
+			return nil
+		}
+	}
+
+	// this host source is no longer on the target, destroy this resource
+	d.SetId("")
+	return nil
+}
+
+func resourceTargetHostSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	tc := targets.NewClient(md.client)
+
+	targetId := d.Get(targetIdKey).(string)
+	hostSourceId := d.Get(targetHostSourceIdKey).(string)
+
+	resourceMutexKV.Lock(targetId)
+	defer resourceMutexKV.Unlock(targetId)
+
+	_, err := tc.RemoveHostSources(ctx, targetId, 0, []string{hostSourceId}, targets.WithAutomaticVersioning(true))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error removing host source from target: %v", err)
+	}
+
+	return nil
+}
+
+func resourceTargetHostSourceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitAttachmentId(d.Id(), 2)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set(targetIdKey, parts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set(targetHostSourceIdKey, parts[1]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
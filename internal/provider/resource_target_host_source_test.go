@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	fooTargetWithoutSources = `
+resource "boundary_target" "foo" {
+	name         = "test"
+	description  = "sources managed elsewhere"
+	type         = "tcp"
+	scope_id     = boundary_scope.proj1.id
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+
+	lifecycle {
+		ignore_changes = [
+			host_source_ids,
+			brokered_credential_source_ids,
+			injected_application_credential_source_ids,
+		]
+	}
+}`
+
+	targetHostSourceFoo = `
+resource "boundary_target_host_source" "foo" {
+	target_id      = boundary_target.foo.id
+	host_source_id = boundary_host_set.foo.id
+}`
+
+	targetHostSourceBar = `
+resource "boundary_target_host_source" "bar" {
+	target_id      = boundary_target.foo.id
+	host_source_id = boundary_host_set.bar.id
+}`
+)
+
+func TestAccTargetHostSource(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// test create
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, fooTargetWithoutSources, targetHostSourceFoo),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.foo"),
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.foo", []string{"boundary_host_set.foo"}),
+				),
+			},
+			importStep("boundary_target_host_source.foo"),
+			{
+				// add a second host source, the first one must be left untouched
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, fooTargetWithoutSources, targetHostSourceFoo, targetHostSourceBar),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.foo", []string{"boundary_host_set.foo", "boundary_host_set.bar"}),
+				),
+			},
+			importStep("boundary_target_host_source.bar"),
+			{
+				// remove the first host source
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, fooTargetWithoutSources, targetHostSourceBar),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceHostSource(provider, "boundary_target.foo", []string{"boundary_host_set.bar"}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTargetSsh() *schema.Resource {
+	return &schema.Resource{
+		Description: "The SSH target resource allows you to configure a Boundary target of type `ssh`. Besides brokered credentials, " +
+			"SSH targets support injected application credentials and, on HCP and Enterprise, session recording.",
+
+		CreateContext: resourceTargetCreate(targetTypeSsh),
+		ReadContext:   resourceTargetRead(targetTypeSsh),
+		UpdateContext: resourceTargetUpdate(targetTypeSsh),
+		DeleteContext: resourceTargetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema:        targetSchema(targetTypeSsh),
+		CustomizeDiff: resourceTargetCustomizeDiff(targetTypeSsh),
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	fooTargetSsh = `
+resource "boundary_target_ssh" "foo" {
+	name                = "test"
+	description         = "bar"
+	scope_id            = boundary_scope.proj1.id
+	default_port        = 22
+	default_client_port = 2222
+	depends_on          = [boundary_role.proj1_admin]
+}`
+
+	fooTargetSshUpdate = `
+resource "boundary_target_ssh" "foo" {
+	name                     = "test"
+	description              = "foo bar"
+	scope_id                 = boundary_scope.proj1.id
+	default_port             = 2022
+	session_connection_limit = 2
+	depends_on               = [boundary_role.proj1_admin]
+}`
+
+	fooTargetSshRecordingWithoutBucket = `
+resource "boundary_target_ssh" "foo" {
+	name                     = "test"
+	scope_id                 = boundary_scope.proj1.id
+	default_port             = 22
+	enable_session_recording = true
+	depends_on               = [boundary_role.proj1_admin]
+}`
+)
+
+func TestAccTargetSsh(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fooTargetSshRecordingWithoutBucket),
+				ExpectError: regexp.MustCompile(`"enable_session_recording"\s+requires\s+"storage_bucket_id"\s+to\s+be\s+set`),
+			},
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooTargetSsh),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target_ssh.foo"),
+					resource.TestCheckResourceAttr("boundary_target_ssh.foo", DescriptionKey, "bar"),
+					resource.TestCheckResourceAttr("boundary_target_ssh.foo", targetDefaultPortKey, "22"),
+					resource.TestCheckResourceAttr("boundary_target_ssh.foo", targetDefaultClientPortKey, "2222"),
+				),
+			},
+			importStep("boundary_target_ssh.foo"),
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooTargetSshUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target_ssh.foo"),
+					resource.TestCheckResourceAttr("boundary_target_ssh.foo", DescriptionKey, "foo bar"),
+					resource.TestCheckResourceAttr("boundary_target_ssh.foo", targetDefaultPortKey, "2022"),
+					resource.TestCheckResourceAttr("boundary_target_ssh.foo", targetSessionConnectionLimitKey, "2"),
+				),
+			},
+			importStep("boundary_target_ssh.foo"),
+		},
+	})
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTargetTcp() *schema.Resource {
+	return &schema.Resource{
+		Description: "The TCP target resource allows you to configure a Boundary target of type `tcp`, which proxies TCP connections " +
+			"to its hosts. Brokered credentials are returned to the user when connecting.",
+
+		CreateContext: resourceTargetCreate(targetTypeTcp),
+		ReadContext:   resourceTargetRead(targetTypeTcp),
+		UpdateContext: resourceTargetUpdate(targetTypeTcp),
+		DeleteContext: resourceTargetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema:        targetSchema(targetTypeTcp),
+		CustomizeDiff: resourceTargetCustomizeDiff(targetTypeTcp),
+	}
+}
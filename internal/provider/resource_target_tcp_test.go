@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	fooTargetTcp = `
+resource "boundary_target_tcp" "foo" {
+	name                = "test"
+	description         = "bar"
+	scope_id            = boundary_scope.proj1.id
+	default_port        = 22
+	default_client_port = 1022
+	host_source_ids     = [boundary_host_set.foo.id]
+	depends_on          = [boundary_role.proj1_admin]
+}`
+
+	fooTargetTcpUpdate = `
+resource "boundary_target_tcp" "foo" {
+	name                 = "test"
+	description          = "foo bar"
+	scope_id             = boundary_scope.proj1.id
+	default_port         = 80
+	host_source_ids      = [boundary_host_set.bar.id]
+	egress_worker_filter = "\"dev\" in \"/tags/type\""
+	depends_on           = [boundary_role.proj1_admin]
+}`
+
+	fooTargetTcpWithRecording = `
+resource "boundary_target_tcp" "foo" {
+	name                     = "test"
+	scope_id                 = boundary_scope.proj1.id
+	enable_session_recording = true
+	depends_on               = [boundary_role.proj1_admin]
+}`
+
+	fooTargetSshImportedAsTcp = `
+resource "boundary_target_ssh" "foo" {
+	name         = "test-ssh"
+	scope_id     = boundary_scope.proj1.id
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+}
+
+resource "boundary_target_tcp" "bar" {
+	name     = "test-tcp"
+	scope_id = boundary_scope.proj1.id
+}`
+)
+
+func TestAccTargetTcp(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// SSH only attributes are not part of the schema
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fooTargetTcpWithRecording),
+				ExpectError: regexp.MustCompile(`An argument named "enable_session_recording" is not expected here`),
+			},
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, fooTargetTcp),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target_tcp.foo"),
+					resource.TestCheckResourceAttr("boundary_target_tcp.foo", DescriptionKey, "bar"),
+					resource.TestCheckResourceAttr("boundary_target_tcp.foo", targetDefaultPortKey, "22"),
+					resource.TestCheckResourceAttr("boundary_target_tcp.foo", targetDefaultClientPortKey, "1022"),
+					testAccCheckTargetResourceHostSource(provider, "boundary_target_tcp.foo", []string{"boundary_host_set.foo"}),
+				),
+			},
+			importStep("boundary_target_tcp.foo"),
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, fooTargetTcpUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target_tcp.foo"),
+					resource.TestCheckResourceAttr("boundary_target_tcp.foo", DescriptionKey, "foo bar"),
+					resource.TestCheckResourceAttr("boundary_target_tcp.foo", targetDefaultPortKey, "80"),
+					resource.TestCheckResourceAttr("boundary_target_tcp.foo", targetWorkerEgressFilterKey, `"dev" in "/tags/type"`),
+					testAccCheckTargetResourceHostSource(provider, "boundary_target_tcp.foo", []string{"boundary_host_set.bar"}),
+				),
+			},
+			importStep("boundary_target_tcp.foo"),
+		},
+	})
+}
+
+func TestAccTargetTcp_ImportWrongType(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooTargetSshImportedAsTcp),
+				Check:  testAccCheckTargetResourceExists(provider, "boundary_target_ssh.foo"),
+			},
+			{
+				Config:            testConfig(url, fooOrg, firstProjectFoo, fooTargetSshImportedAsTcp),
+				ResourceName:      "boundary_target_tcp.bar",
+				ImportState:       true,
+				ImportStateIdFunc: importStateIdFromResource("boundary_target_ssh.foo"),
+				ExpectError:       regexp.MustCompile(`has\s+type\s+"ssh",\s+expected\s+"tcp"`),
+			},
+		},
+	})
+}
+
+// importStateIdFromResource imports the ID of another resource.
+func importStateIdFromResource(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", name)
+		}
+		return rs.Primary.ID, nil
+	}
+}
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/testing/controller"
 	"github.com/hashicorp/boundary/testing/vault"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -291,23 +293,13 @@ func TestAccTarget(t *testing.T) {
 			},
 			importStep("boundary_target.foo"),
 			{
-				// test updating state file when the target is created, but fails on associating an invalid injected credential source to a tcp target type
-				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, fooBarCredLibs, fooTargetPartialSuccess),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTargetResourceExists(provider, "boundary_target.foo"),
-					resource.TestCheckResourceAttr("boundary_target.foo", DescriptionKey, ""),
-					resource.TestCheckResourceAttr("boundary_target.foo", targetDefaultPortKey, "80"),
-					resource.TestCheckResourceAttr("boundary_target.foo", targetDefaultClientPortKey, "8022"),
-					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionMaxSecondsKey, "7000"),
-					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionConnectionLimitKey, "7"),
-					resource.TestCheckResourceAttr("boundary_target.foo", targetHostSourceIdsKey+".%", "0"),
-					resource.TestCheckResourceAttr("boundary_target.foo", targetInjectedAppCredentialSourceIdsKey+".%", "0"),
-				),
-				ExpectError: regexp.MustCompile("Unable to set credential sources in target: tcp.VetCredentialSources: tcp.Target only supports credential purpose"),
+				// injected credential sources are rejected on tcp targets at plan time, so the target is left unchanged
+				Config:      testConfig(url, fooOrg, firstProjectFoo, credStoreRes, fooBarCredLibs, fooTargetPartialSuccess),
+				ExpectError: regexp.MustCompile(`"injected_application_credential_source_ids"\s+is\s+only\s+supported\s+on\s+ssh\s+targets,\s+not\s+on\s+tcp\s+targets`),
 			},
 			importStep("boundary_target.foo", targetInjectedAppCredentialSourceIdsKey),
 			{
-				// test resolving invalid injected credential source error without raising duplicate name error, due to state file not being in sync.
+				// the rejected plan left the target untouched, applying the previous configuration again is a no-op
 				Config: testConfig(url, fooOrg, firstProjectFoo, credStoreRes, fooBarCredLibs, fooTargetUpdateUnsetHostAndCredSources),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTargetResourceExists(provider, "boundary_target.foo"),
@@ -457,6 +449,117 @@ func TestAccTargetWithAddress_HostSourceAndAddressConflict(t *testing.T) {
 	})
 }
 
+const (
+	fooTargetInvalidType = `
+resource "boundary_target" "foo" {
+	name         = "test"
+	type         = "udp"
+	scope_id     = boundary_scope.proj1.id
+	default_port = 22
+	depends_on   = [boundary_role.proj1_admin]
+}`
+
+	fooTcpTargetWithRecording = `
+resource "boundary_target" "foo" {
+	name                     = "test"
+	type                     = "tcp"
+	scope_id                 = boundary_scope.proj1.id
+	default_port             = 22
+	enable_session_recording = true
+	storage_bucket_id        = "sb_1234567890"
+	depends_on               = [boundary_role.proj1_admin]
+}`
+
+	fooSshTargetRecordingWithoutBucket = `
+resource "boundary_target" "foo" {
+	name                     = "test"
+	type                     = "ssh"
+	scope_id                 = boundary_scope.proj1.id
+	default_port             = 22
+	enable_session_recording = true
+	depends_on               = [boundary_role.proj1_admin]
+}`
+)
+
+const (
+	fooTargetSessionMaxDuration = `
+resource "boundary_target" "foo" {
+	name                 = "test"
+	type                 = "tcp"
+	scope_id             = boundary_scope.proj1.id
+	default_port         = 22
+	session_max_duration = "%s"
+	depends_on           = [boundary_role.proj1_admin]
+}`
+)
+
+func TestAccTarget_SessionMaxDuration(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(fooTargetSessionMaxDuration, "2h")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, "boundary_target.foo"),
+					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionMaxDurationKey, "2h"),
+					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionMaxSecondsKey, "7200"),
+				),
+			},
+			{
+				// an equivalent duration is not a change
+				Config:   testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(fooTargetSessionMaxDuration, "120m")),
+				PlanOnly: true,
+			},
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(fooTargetSessionMaxDuration, "90m")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("boundary_target.foo", targetSessionMaxSecondsKey, "5400"),
+				),
+			},
+			{
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fmt.Sprintf(fooTargetSessionMaxDuration, "1500ms")),
+				ExpectError: regexp.MustCompile(`invalid duration`),
+			},
+		},
+	})
+}
+
+func TestAccTarget_TypeValidation(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fooTargetInvalidType),
+				ExpectError: regexp.MustCompile(`unsupported\s+target\s+type\s+"udp",\s+expected\s+"tcp"\s+or\s+"ssh"`),
+			},
+			{
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fooTcpTargetWithRecording),
+				ExpectError: regexp.MustCompile(`"enable_session_recording"\s+is\s+only\s+supported\s+on\s+ssh\s+targets,\s+not\s+on\s+tcp\s+targets`),
+			},
+			{
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fooSshTargetRecordingWithoutBucket),
+				ExpectError: regexp.MustCompile(`"enable_session_recording"\s+requires\s+"storage_bucket_id"\s+to\s+be\s+set`),
+			},
+		},
+	})
+}
+
 func testAccCheckTargetResourceHostSource(testProvider *schema.Provider, name string, hostSources []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -604,7 +707,7 @@ func testAccCheckTargetResourceDestroy(t *testing.T, testProvider *schema.Provid
 
 		for _, rs := range s.RootModule().Resources {
 			switch rs.Type {
-			case "boundary_target":
+			case "boundary_target", "boundary_target_tcp", "boundary_target_ssh":
 				tgts := targets.NewClient(md.client)
 
 				id := rs.Primary.ID
@@ -621,3 +724,73 @@ func testAccCheckTargetResourceDestroy(t *testing.T, testProvider *schema.Provid
 		return nil
 	}
 }
+
+func TestTargetWorkerFilterWarnings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceTarget().Schema, map[string]interface{}{
+		targetWorkerFilterKey: `"dev" in "/tags/type"`,
+	})
+	if diags := targetWorkerFilterWarnings(d); len(diags) != 0 {
+		t.Fatalf("unexpected warnings: %v", diags)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceTarget().Schema, map[string]interface{}{
+		targetWorkerFilterKey:       `"dev" in "/tags/type"`,
+		targetWorkerEgressFilterKey: `"egress" in "/tags/type"`,
+	})
+	diags := targetWorkerFilterWarnings(d)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected one warning, got %v", diags)
+	}
+	if want := `"worker_filter" is combined with "egress_worker_filter"`; diags[0].Summary != want {
+		t.Fatalf("got summary %q, want %q", diags[0].Summary, want)
+	}
+}
+
+func TestTargetResponseAttributes(t *testing.T) {
+	raw := map[string]interface{}{
+		"id":       "ttcp_1234567890",
+		"type":     targetTypeTcp,
+		"name":     "foo",
+		"scope_id": "p_1234567890",
+		"attributes": map[string]interface{}{
+			targetDefaultPortKey:       json.Number("22"),
+			targetDefaultClientPortKey: json.Number("2222"),
+			"future_attribute":         "value",
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceTargetTcp().Schema, nil)
+	if err := setFromTargetResponseMap(d, raw, targetTypeTcp); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Get(targetDefaultPortKey); got != 22 {
+		t.Fatalf("got default port %v, want 22", got)
+	}
+	if got := d.Get(targetDefaultClientPortKey); got != 2222 {
+		t.Fatalf("got default client port %v, want 2222", got)
+	}
+	want := `{"default_client_port":2222,"default_port":22,"future_attribute":"value"}`
+	if got := d.Get(AttributesJsonKey); got != want {
+		t.Fatalf("got attributes %v, want %s", got, want)
+	}
+
+	// The generic resource keeps the attributes of types it does not know
+	raw["type"] = "future"
+	d = schema.TestResourceDataRaw(t, resourceTarget().Schema, nil)
+	if err := setFromTargetResponseMap(d, raw, ""); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Get(TypeKey); got != "future" {
+		t.Fatalf("got type %v, want future", got)
+	}
+	if got := d.Get(AttributesJsonKey); got != want {
+		t.Fatalf("got attributes %v, want %s", got, want)
+	}
+
+	// A typed resource refuses targets of other types
+	raw["type"] = targetTypeSsh
+	d = schema.TestResourceDataRaw(t, resourceTargetTcp().Schema, nil)
+	wantErr := `target "ttcp_1234567890" has type "ssh", expected "tcp"`
+	if err := setFromTargetResponseMap(d, raw, targetTypeTcp); err == nil || err.Error() != wantErr {
+		t.Fatalf("got error %v, want %s", err, wantErr)
+	}
+}
@@ -44,16 +44,22 @@ func resourceUser() *schema.Resource {
 				Optional:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "The scope ID in which the resource is created. Defaults to the provider's `default_scope` if unset.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(orgScopeIds...),
 			},
 			userAccountIDsKey: {
-				Description: "Account ID's to associate with this user resource.",
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Account ID's to associate with this user resource. Updates only add or remove the " +
+					"accounts that changed. When `boundary_user_account` also manages accounts of this user, list this " +
+					"attribute in `ignore_changes`.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateId(accountIds),
+				},
 			},
 		},
 	}
@@ -205,21 +211,24 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
+	// Accounts may also be associated by boundary_user_account, so only the
+	// accounts that changed are added or removed.
 	if d.HasChange(userAccountIDsKey) {
-		var accountIds []string
-		if accountsVal, ok := d.GetOk(userAccountIDsKey); ok {
-			accounts := accountsVal.(*schema.Set).List()
-			for _, account := range accounts {
-				accountIds = append(accountIds, account.(string))
+		resourceMutexKV.Lock(d.Id())
+		defer resourceMutexKV.Unlock(d.Id())
+
+		removed, added := setChanges(d, userAccountIDsKey)
+		if len(removed) > 0 {
+			_, err := usrs.RemoveAccounts(ctx, d.Id(), 0, removed, users.WithAutomaticVersioning(true))
+			if err != nil {
+				return diag.Errorf("error removing accounts from user: %v", err)
 			}
-
 		}
-		_, err := usrs.SetAccounts(ctx, d.Id(), 0, accountIds, users.WithAutomaticVersioning(true))
-		if err != nil {
-			return diag.Errorf("error updating accounts on user: %v", err)
-		}
-		if err := d.Set(userAccountIDsKey, accountIds); err != nil {
-			return diag.FromErr(err)
+		if len(added) > 0 {
+			_, err := usrs.AddAccounts(ctx, d.Id(), 0, added, users.WithAutomaticVersioning(true))
+			if err != nil {
+				return diag.Errorf("error adding accounts to user: %v", err)
+			}
 		}
 	}
 
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/users"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	userIdKey        = "user_id"
+	userAccountIdKey = "account_id"
+)
+
+func resourceUserAccount() *schema.Resource {
+	return &schema.Resource{
+		Description: "The user account resource allows you to associate a single account with a Boundary user " +
+			"without managing the full set of accounts of that user. The `boundary_user` resource must omit " +
+			"`account_ids` and list it in `ignore_changes`, otherwise both resources will fight over the accounts.",
+
+		CreateContext: resourceUserAccountCreate,
+		ReadContext:   resourceUserAccountRead,
+		DeleteContext: resourceUserAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserAccountImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			IDKey: {
+				Description: "The ID of the user account, in the form `<user_id>:<account_id>`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			userIdKey: {
+				Description:      "The ID of the user to associate the account with.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(userIds),
+			},
+			userAccountIdKey: {
+				Description:      "The ID of the account to associate with the user.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateId(accountIds),
+			},
+		},
+	}
+}
+
+func resourceUserAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	usrs := users.NewClient(md.client)
+
+	userId := d.Get(userIdKey).(string)
+	accountId := d.Get(userAccountIdKey).(string)
+
+	resourceMutexKV.Lock(userId)
+	defer resourceMutexKV.Unlock(userId)
+
+	_, err := usrs.AddAccounts(ctx, userId, 0, []string{accountId}, users.WithAutomaticVersioning(true))
+	if err != nil {
+		return diag.Errorf("error adding account to user: %v", err)
+	}
+
+	d.SetId(attachmentId(userId, accountId))
+
+	return nil
+}
+
+func resourceUserAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	usrs := users.NewClient(md.client)
+
+	userId := d.Get(userIdKey).(string)
+	accountId := d.Get(userAccountIdKey).(string)
+
+	u, err := usrs.Read(ctx, userId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			// the user is gone, destroy this resource
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading user: %v", err)
+	}
+	if u == nil {
+		return diag.Errorf("user nil after read")
+	}
+
+	for _, id := range u.GetItem().AccountIds {
+		if id == accountId {
+			return nil
+		}
+	}
+
+	// this account is no longer associated with the user, destroy this resource
+	d.SetId("")
+	return nil
+}
+
+func resourceUserAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	md := meta.(*metaData)
+	usrs := users.NewClient(md.client)
+
+	userId := d.Get(userIdKey).(string)
+	accountId := d.Get(userAccountIdKey).(string)
+
+	resourceMutexKV.Lock(userId)
+	defer resourceMutexKV.Unlock(userId)
+
+	_, err := usrs.RemoveAccounts(ctx, userId, 0, []string{accountId}, users.WithAutomaticVersioning(true))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return diag.Errorf("error removing account from user: %v", err)
+	}
+
+	return nil
+}
+
+func resourceUserAccountImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitAttachmentId(d.Id(), 2)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set(userIdKey, parts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set(userAccountIdKey, parts[1]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	orgUserWithoutAccts = `
+resource "boundary_user" "org1" {
+	name        = "test"
+	description = "without accts"
+	scope_id    = boundary_scope.org1.id
+	depends_on  = [boundary_role.org1_admin]
+
+	lifecycle {
+		ignore_changes = [account_ids]
+	}
+}`
+
+	userAccountFoo = `
+resource "boundary_user_account" "foo" {
+	user_id    = boundary_user.org1.id
+	account_id = boundary_account.foo.id
+}`
+)
+
+func TestAccUserAccount(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	defer tc.Shutdown()
+	url := tc.ApiAddrs()[0]
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckUserResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// test create
+				Config: testConfig(url, fooOrg, fooAccount, orgUserWithoutAccts, userAccountFoo),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserResourceExists(provider, "boundary_user.org1"),
+					testAccCheckAccountResourceExists(provider, "boundary_account.foo"),
+					testAccCheckUserResourceAccountsSet(provider, "boundary_user.org1", []string{"boundary_account.foo"}),
+				),
+			},
+			importStep("boundary_user_account.foo"),
+			{
+				// removing the attachment must leave the user in place
+				Config: testConfig(url, fooOrg, fooAccount, orgUserWithoutAccts),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserResourceExists(provider, "boundary_user.org1"),
+					resource.TestCheckResourceAttr("boundary_user.org1", DescriptionKey, "without accts"),
+				),
+			},
+		},
+	})
+}
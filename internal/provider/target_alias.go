@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const targetAliasKey = "alias"
+
+// targetAliasSchema returns the schema of the alias blocks of the target
+// resources. Only the aliases created through these blocks are tracked, so
+// that aliases managed with boundary_alias_target are left alone.
+func targetAliasSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Aliases to create along with the target. They are updated with the target and deleted before it. " +
+			"Aliases pointing to the target that are managed with `boundary_alias_target` are not affected, and " +
+			"existing aliases are not imported with the target.",
+		Type:     schema.TypeSet,
+		Optional: true,
+		Set:      targetAliasHash,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				IDKey: {
+					Description: "The ID of the alias.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				ValueKey: {
+					Description: "The value of the alias.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				ScopeIdKey: {
+					Description:      "The scope ID of the alias. Defaults to `global`, the only scope aliases can be created in.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "global",
+					ValidateDiagFunc: validateId(globalScopeIds),
+				},
+				aliasTargetAuthorizeSessionHostIdKey: {
+					Description:      "The host ID used when authorizing a session through the alias.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validateId(hostIds...),
+				},
+			},
+		},
+	}
+}
+
+// targetAliasHash hashes an alias block without its computed ID, so that
+// blocks read from Boundary match the configured ones.
+func targetAliasHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	scopeId, _ := m[ScopeIdKey].(string)
+	if scopeId == "" {
+		scopeId = "global"
+	}
+	hostId, _ := m[aliasTargetAuthorizeSessionHostIdKey].(string)
+	return schema.HashString(fmt.Sprintf("%s|%s|%s", m[ValueKey], scopeId, hostId))
+}
+
+// targetAliasBlock is an alias block of a target resource.
+type targetAliasBlock struct {
+	id      string
+	value   string
+	scopeId string
+	hostId  string
+}
+
+func (a targetAliasBlock) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		IDKey:                                a.id,
+		ValueKey:                             a.value,
+		ScopeIdKey:                           a.scopeId,
+		aliasTargetAuthorizeSessionHostIdKey: a.hostId,
+	}
+}
+
+// targetAliasBlocks returns the alias blocks of a set, keyed by value since
+// alias values are unique.
+func targetAliasBlocks(set *schema.Set) map[string]targetAliasBlock {
+	blocks := make(map[string]targetAliasBlock, set.Len())
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+		a := targetAliasBlock{value: m[ValueKey].(string)}
+		a.id, _ = m[IDKey].(string)
+		a.scopeId, _ = m[ScopeIdKey].(string)
+		a.hostId, _ = m[aliasTargetAuthorizeSessionHostIdKey].(string)
+		if a.scopeId == "" {
+			a.scopeId = "global"
+		}
+		blocks[a.value] = a
+	}
+	return blocks
+}
+
+func setTargetAliasBlocks(d *schema.ResourceData, blocks []targetAliasBlock) error {
+	list := make([]interface{}, 0, len(blocks))
+	for _, a := range blocks {
+		list = append(list, a.toMap())
+	}
+	return d.Set(targetAliasKey, list)
+}
+
+// targetAliasCreateOption returns the option creating the configured aliases
+// along with the target, if any.
+func targetAliasCreateOption(d *schema.ResourceData) (targets.Option, bool) {
+	blocks := targetAliasBlocks(d.Get(targetAliasKey).(*schema.Set))
+	if len(blocks) == 0 {
+		return nil, false
+	}
+	list := make([]targets.Alias, 0, len(blocks))
+	for _, a := range blocks {
+		alias := targets.Alias{
+			Value:   a.value,
+			ScopeId: a.scopeId,
+		}
+		if a.hostId != "" {
+			alias.Attributes = &targets.TargetAliasAttributes{
+				AuthorizeSessionArguments: &targets.AuthorizeSessionArguments{
+					HostId: a.hostId,
+				},
+			}
+		}
+		list = append(list, alias)
+	}
+	return targets.WithAliases(list), true
+}
+
+// setTargetAliasesFromCreate records the IDs of the aliases created along
+// with a target.
+func setTargetAliasesFromCreate(d *schema.ResourceData, created []*targets.Alias) error {
+	blocks := targetAliasBlocks(d.Get(targetAliasKey).(*schema.Set))
+	if len(blocks) == 0 {
+		return nil
+	}
+	ids := make(map[string]string, len(created))
+	for _, a := range created {
+		ids[a.Value] = a.Id
+	}
+	list := make([]targetAliasBlock, 0, len(blocks))
+	for _, a := range blocks {
+		a.id = ids[a.value]
+		list = append(list, a)
+	}
+	return setTargetAliasBlocks(d, list)
+}
+
+// readTargetAliases refreshes the tracked aliases of a target. Aliases that
+// were deleted or now point elsewhere are no longer tracked.
+func readTargetAliases(ctx context.Context, client *api.Client, d *schema.ResourceData) error {
+	blocks := targetAliasBlocks(d.Get(targetAliasKey).(*schema.Set))
+	if len(blocks) == 0 {
+		return nil
+	}
+	ac := aliases.NewClient(client)
+	list := make([]targetAliasBlock, 0, len(blocks))
+	for _, a := range blocks {
+		if a.id == "" {
+			continue
+		}
+		arr, err := ac.Read(ctx, a.id)
+		if err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("error reading alias %q: %w", a.id, err)
+		}
+		if arr == nil {
+			return fmt.Errorf("alias %q nil after read", a.id)
+		}
+		if arr.Item.DestinationId != d.Id() {
+			continue
+		}
+		read, err := targetAliasBlockFromAlias(arr.Item)
+		if err != nil {
+			return err
+		}
+		list = append(list, read)
+	}
+	return setTargetAliasBlocks(d, list)
+}
+
+func targetAliasBlockFromAlias(alias *aliases.Alias) (targetAliasBlock, error) {
+	a := targetAliasBlock{
+		id:      alias.Id,
+		value:   alias.Value,
+		scopeId: alias.ScopeId,
+	}
+	if alias.Attributes != nil {
+		attrs, err := aliases.AttributesMapToTargetAliasAttributes(alias.Attributes)
+		if err != nil {
+			return a, err
+		}
+		if attrs.AuthorizeSessionArguments != nil {
+			a.hostId = attrs.AuthorizeSessionArguments.HostId
+		}
+	}
+	return a, nil
+}
+
+// updateTargetAliases reconciles the aliases of a target with its alias
+// blocks. Aliases are matched by value: removed ones are deleted, added ones
+// are created and the others are updated in place when their host changed.
+// Moving an alias to another scope requires recreating it.
+func updateTargetAliases(ctx context.Context, client *api.Client, d *schema.ResourceData) error {
+	o, n := d.GetChange(targetAliasKey)
+	oldBlocks := targetAliasBlocks(o.(*schema.Set))
+	newBlocks := targetAliasBlocks(n.(*schema.Set))
+	ac := aliases.NewClient(client)
+
+	for value, old := range oldBlocks {
+		if a, ok := newBlocks[value]; ok && a.scopeId == old.scopeId {
+			continue
+		}
+		if err := deleteTargetAlias(ctx, ac, old.id); err != nil {
+			return err
+		}
+	}
+
+	list := make([]targetAliasBlock, 0, len(newBlocks))
+	for value, a := range newBlocks {
+		old, ok := oldBlocks[value]
+		switch {
+		case ok && old.scopeId == a.scopeId && old.hostId == a.hostId:
+			a.id = old.id
+
+		case ok && old.scopeId == a.scopeId:
+			opts := []aliases.Option{
+				aliases.WithAutomaticVersioning(true),
+				aliases.DefaultTargetAliasAuthorizeSessionArgumentsHostId(),
+			}
+			if a.hostId != "" {
+				opts = append(opts, aliases.WithTargetAliasAuthorizeSessionArgumentsHostId(a.hostId))
+			}
+			if _, err := ac.Update(ctx, old.id, 0, opts...); err != nil {
+				return fmt.Errorf("error updating alias %q: %w", old.id, err)
+			}
+			a.id = old.id
+
+		default:
+			opts := []aliases.Option{
+				aliases.WithValue(a.value),
+				aliases.WithDestinationId(d.Id()),
+			}
+			if a.hostId != "" {
+				opts = append(opts, aliases.WithTargetAliasAuthorizeSessionArgumentsHostId(a.hostId))
+			}
+			acr, err := ac.Create(ctx, aliasTypeTarget, a.scopeId, opts...)
+			if err != nil {
+				return fmt.Errorf("error creating alias %q: %w", a.value, err)
+			}
+			if acr == nil {
+				return fmt.Errorf("alias %q nil after create", a.value)
+			}
+			a.id = acr.Item.Id
+		}
+		list = append(list, a)
+	}
+	return setTargetAliasBlocks(d, list)
+}
+
+// deleteTargetAliases deletes the tracked aliases of a target so that they
+// are not left dangling once the target is deleted.
+func deleteTargetAliases(ctx context.Context, client *api.Client, d *schema.ResourceData) error {
+	ac := aliases.NewClient(client)
+	for _, a := range targetAliasBlocks(d.Get(targetAliasKey).(*schema.Set)) {
+		if err := deleteTargetAlias(ctx, ac, a.id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteTargetAlias(ctx context.Context, ac *aliases.Client, id string) error {
+	if id == "" {
+		return nil
+	}
+	if _, err := ac.Delete(ctx, id); err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Response().StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf("error deleting alias %q: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	fooTargetWithAliases = `
+resource "boundary_target" "foo" {
+	type         = "tcp"
+	name         = "test"
+	default_port = 22
+	address      = "127.0.0.1"
+	scope_id     = boundary_scope.proj1.id
+	depends_on   = [boundary_role.proj1_admin]
+
+	alias {
+		value = "foo.example"
+	}
+
+	alias {
+		value                     = "bar.example"
+		authorize_session_host_id = "hst_1234567890"
+	}
+}`
+
+	fooTargetWithAliasesUpdate = `
+resource "boundary_target" "foo" {
+	type         = "tcp"
+	name         = "test"
+	default_port = 22
+	address      = "127.0.0.1"
+	scope_id     = boundary_scope.proj1.id
+	depends_on   = [boundary_role.proj1_admin]
+
+	alias {
+		value                     = "bar.example"
+		authorize_session_host_id = "hst_0987654321"
+	}
+
+	alias {
+		value = "baz.example"
+	}
+}`
+
+	fooTargetWithoutAliases = `
+resource "boundary_target" "foo" {
+	type         = "tcp"
+	name         = "test"
+	default_port = 22
+	address      = "127.0.0.1"
+	scope_id     = boundary_scope.proj1.id
+	depends_on   = [boundary_role.proj1_admin]
+}`
+)
+
+func TestAccTarget_Aliases(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+
+	url := tc.ApiAddrs()[0]
+	const name = "boundary_target.foo"
+
+	var provider *schema.Provider
+	var created, updated []string
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooTargetWithAliases),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, name),
+					resource.TestCheckResourceAttr(name, "alias.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "alias.*", map[string]string{
+						ValueKey:   "foo.example",
+						ScopeIdKey: "global",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "alias.*", map[string]string{
+						ValueKey:                             "bar.example",
+						aliasTargetAuthorizeSessionHostIdKey: "hst_1234567890",
+					}),
+					testAccCheckTargetAliases(provider, name, &created),
+				),
+			},
+			// aliases are not imported with the target
+			importStep(name, targetAliasKey),
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooTargetWithAliasesUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "alias.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "alias.*", map[string]string{
+						ValueKey:                             "bar.example",
+						aliasTargetAuthorizeSessionHostIdKey: "hst_0987654321",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "alias.*", map[string]string{
+						ValueKey: "baz.example",
+					}),
+					testAccCheckTargetAliases(provider, name, &updated),
+					testAccCheckAliasesDeleted(provider, func() []string {
+						// foo.example was removed, bar.example was updated in place
+						var removed []string
+						for _, id := range created {
+							if !slices.Contains(updated, id) {
+								removed = append(removed, id)
+							}
+						}
+						return removed
+					}),
+				),
+			},
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooTargetWithoutAliases),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "alias.#", "0"),
+					testAccCheckAliasesDeleted(provider, func() []string { return updated }),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckTargetAliases checks that the aliases tracked by a target exist
+// and point to it, and stores their IDs.
+func testAccCheckTargetAliases(testProvider *schema.Provider, name string, ids *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		md := testProvider.Meta().(*metaData)
+		ac := aliases.NewClient(md.client)
+
+		*ids = nil
+		for key, id := range rs.Primary.Attributes {
+			if !isTargetAliasIdAttribute(key) {
+				continue
+			}
+			ar, err := ac.Read(context.Background(), id)
+			if err != nil {
+				return fmt.Errorf("Got an error when reading alias %q: %v", id, err)
+			}
+			if ar.Item.DestinationId != rs.Primary.ID {
+				return fmt.Errorf("alias %q points to %q, expected %q", id, ar.Item.DestinationId, rs.Primary.ID)
+			}
+			*ids = append(*ids, id)
+		}
+		return nil
+	}
+}
+
+// isTargetAliasIdAttribute reports whether a flatmapped state key is the ID of
+// an alias block, e.g. "alias.1234.id".
+func isTargetAliasIdAttribute(key string) bool {
+	return strings.HasPrefix(key, targetAliasKey+".") && strings.HasSuffix(key, "."+IDKey)
+}
+
+// testAccCheckAliasesDeleted checks that the aliases with the given IDs do not
+// exist anymore.
+func testAccCheckAliasesDeleted(testProvider *schema.Provider, ids func() []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		md := testProvider.Meta().(*metaData)
+		ac := aliases.NewClient(md.client)
+
+		for _, id := range ids() {
+			_, err := ac.Read(context.Background(), id)
+			if apiErr := api.AsServerError(err); apiErr == nil || apiErr.Response().StatusCode() != http.StatusNotFound {
+				return fmt.Errorf("didn't get a 404 when reading deleted alias %q: %v", id, err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	targetHostSourcesKey               = "host_sources"
+	targetHostSourceHostCatalogNameKey = "host_catalog_name"
+	targetHostSourceHostSetNameKey     = "host_set_name"
+)
+
+func targetHostSourcesSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Host sets to add to the target, referenced by the name of their host catalog in the scope " +
+			"of the target and their own name. They are resolved to IDs when planning and added to " +
+			"`host_source_ids`. Use `depends_on` when the host catalog or host set is created in the same " +
+			"configuration. Cannot be used alongside address.",
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				targetHostSourceHostCatalogNameKey: {
+					Description:  "The name of the host catalog of the host set.",
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				targetHostSourceHostSetNameKey: {
+					Description:  "The name of the host set.",
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+		ConflictsWith: []string{targetAddressKey},
+	}
+}
+
+// resolveTargetHostSources returns the IDs of the host sets referenced by the
+// host_sources blocks of a target in the given scope.
+func resolveTargetHostSources(ctx context.Context, client *api.Client, scopeId string, blocks []interface{}) ([]string, error) {
+	catalogIds := map[string]string{}
+	ids := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		block := b.(map[string]interface{})
+		catalogName := block[targetHostSourceHostCatalogNameKey].(string)
+		catalogId, ok := catalogIds[catalogName]
+		if !ok {
+			var err error
+			catalogId, err = hostCatalogIdByName(ctx, client, scopeId, catalogName)
+			if err != nil {
+				return nil, err
+			}
+			catalogIds[catalogName] = catalogId
+		}
+		id, err := hostSetIdByName(ctx, client, catalogId, block[targetHostSourceHostSetNameKey].(string))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// planTargetHostSourceIds plans host_source_ids with the IDs the host_sources
+// blocks resolve to. host_source_ids is computed to hold them, so it also has
+// to be cleared here when it is removed from the configuration. The IDs are
+// left unknown when a host catalog or host set does not exist yet, to be
+// resolved again when applying.
+func planTargetHostSourceIds(ctx context.Context, d *schema.ResourceDiff, md *metaData) error {
+	configured, known := rawConfigStrings(d.GetRawConfig(), targetHostSourceIdsKey)
+	blocks := d.Get(targetHostSourcesKey).(*schema.Set).List()
+	if len(blocks) == 0 && d.NewValueKnown(targetHostSourcesKey) {
+		if known && len(configured) == 0 && d.Get(targetHostSourceIdsKey).(*schema.Set).Len() > 0 {
+			return d.SetNew(targetHostSourceIdsKey, []string{})
+		}
+		return nil
+	}
+
+	if !known || !d.NewValueKnown(targetHostSourcesKey) || !d.NewValueKnown(ScopeIdKey) {
+		return d.SetNewComputed(targetHostSourceIdsKey)
+	}
+	resolved, err := resolveTargetHostSources(ctx, md.client, d.Get(ScopeIdKey).(string), blocks)
+	var notFound *nameNotFoundError
+	switch {
+	case errors.As(err, &notFound):
+		return d.SetNewComputed(targetHostSourceIdsKey)
+	case err != nil:
+		return err
+	}
+	return d.SetNew(targetHostSourceIdsKey, mergeIds(configured, resolved))
+}
+
+// targetHostSourceIds returns the host source IDs to set on a target when
+// applying, and whether there are any to set. Without host_sources blocks
+// these are the ones of host_source_ids, the blocks are resolved again
+// otherwise since their IDs may not have been known when planning.
+func targetHostSourceIds(ctx context.Context, d *schema.ResourceData, client *api.Client) ([]string, bool, error) {
+	blocks := d.Get(targetHostSourcesKey).(*schema.Set).List()
+	if len(blocks) == 0 {
+		v, ok := d.GetOk(targetHostSourceIdsKey)
+		if !ok {
+			return nil, false, nil
+		}
+		var ids []string
+		for _, id := range v.(*schema.Set).List() {
+			ids = append(ids, id.(string))
+		}
+		return ids, true, nil
+	}
+
+	configured, _ := rawConfigStrings(d.GetRawConfig(), targetHostSourceIdsKey)
+	resolved, err := resolveTargetHostSources(ctx, client, d.Get(ScopeIdKey).(string), blocks)
+	if err != nil {
+		return nil, false, err
+	}
+	return mergeIds(configured, resolved), true, nil
+}
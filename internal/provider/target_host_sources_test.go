@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const fooTargetWithHostSources = `
+resource "boundary_target" "foo" {
+	type         = "tcp"
+	name         = "test"
+	default_port = 22
+	scope_id     = boundary_scope.proj1.id
+	depends_on   = [boundary_role.proj1_admin, boundary_host_set.foo, boundary_host_set.bar]
+
+	%s
+}`
+
+func TestAccTarget_HostSources(t *testing.T) {
+	tc := controller.NewTestController(t, tcConfig...)
+	t.Cleanup(tc.Shutdown)
+
+	url := tc.ApiAddrs()[0]
+	const name = "boundary_target.foo"
+
+	hostSources := func(sets ...string) string {
+		var blocks string
+		for _, set := range sets {
+			blocks += fmt.Sprintf(`
+	host_sources {
+		host_catalog_name = "test"
+		host_set_name     = "%s"
+	}`, set)
+		}
+		return fmt.Sprintf(fooTargetWithHostSources, blocks)
+	}
+
+	var provider *schema.Provider
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:        true,
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckTargetResourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				// the host sets do not exist when planning, they are resolved
+				// when applying
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, hostSources("foo")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceExists(provider, name),
+					testAccCheckTargetResourceHostSource(provider, name, []string{"boundary_host_set.foo"}),
+					resource.TestCheckResourceAttr(name, "host_source_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(name, "host_source_ids.*", "boundary_host_set.foo", IDKey),
+				),
+			},
+			// host sources are imported as host_source_ids
+			importStep(name, targetHostSourcesKey),
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, hostSources("foo", "bar")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceHostSource(provider, name, []string{"boundary_host_set.foo", "boundary_host_set.bar"}),
+					resource.TestCheckResourceAttr(name, "host_source_ids.#", "2"),
+				),
+			},
+			{
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, hostSources("bar")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceHostSource(provider, name, []string{"boundary_host_set.bar"}),
+					resource.TestCheckResourceAttr(name, "host_source_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(name, "host_source_ids.*", "boundary_host_set.bar", IDKey),
+				),
+			},
+			{
+				Config:      testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, hostSources("baz")),
+				ExpectError: regexp.MustCompile(`no host set named "baz" in host catalog`),
+			},
+			{
+				// removing the blocks removes the host sources they resolved to
+				Config: testConfig(url, fooOrg, firstProjectFoo, fooBarHostSet, hostSources()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetResourceHostSource(provider, name, nil),
+					resource.TestCheckResourceAttr(name, "host_source_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterWithItemNameEquals(t *testing.T) {
+	assert.Equal(t, `"/item/name" == "web"`, filterWithItemNameEquals("web"))
+	assert.Equal(t, `"/item/name" == "a \"b\" .*"`, filterWithItemNameEquals(`a "b" .*`))
+}
+
+func TestMergeIds(t *testing.T) {
+	assert.Equal(t, []string{}, mergeIds())
+	assert.Equal(t, []string{"hsst_1", "hsst_2", "hsst_3"}, mergeIds([]string{"hsst_3", "hsst_1"}, []string{"hsst_2", "hsst_1"}))
+}
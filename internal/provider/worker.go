@@ -46,9 +46,10 @@ func resourceWorker() *schema.Resource {
 				Computed:    true,
 			},
 			ScopeIdKey: {
-				Description: "The scope for the worker. Defaults to `global`.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "The scope for the worker. Defaults to `global`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateId(globalScopeIds),
 			},
 			NameKey: {
 				Description: "The name for the worker.",